@@ -0,0 +1,141 @@
+package id3
+
+import (
+	"sort"
+
+	"github.com/cloudcloud/go-id3/frames"
+)
+
+// Chapter is an entry within the chapter tree, resolved from the CHAP and CTOC
+// frames. A nested table of contents is given as a chapter holding its own entries,
+// spanning the times of those entries.
+type Chapter struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	URL         string `json:"url"`
+	StartTime   uint32 `json:"start_time" yaml:"start_time"`
+	EndTime     uint32 `json:"end_time" yaml:"end_time"`
+	StartOffset uint32 `json:"start_offset" yaml:"start_offset"`
+	EndOffset   uint32 `json:"end_offset" yaml:"end_offset"`
+
+	Picture  *frames.APIC `json:"-" yaml:"-"`
+	Chapters []*Chapter   `json:"chapters"`
+
+	// Frame is the CHAP or CTOC frame the chapter was resolved from
+	Frame frames.IFrame `json:"-" yaml:"-"`
+}
+
+// Chapters provides the chapter tree, beginning with the entries of the top level
+// table of contents. Without a table of contents, each chapter is given in order of
+// its start time.
+func (f *V2) Chapters() []*Chapter {
+	chaps := map[string]*frames.CHAP{}
+	tocs := map[string]*frames.CTOC{}
+	all := []*frames.CHAP{}
+	var top *frames.CTOC
+
+	for _, v := range f.Frames {
+		switch x := v.(type) {
+		case *frames.CHAP:
+			chaps[x.ElementID] = x
+			all = append(all, x)
+		case *frames.CTOC:
+			tocs[x.ElementID] = x
+			if x.TopLevel && top == nil {
+				top = x
+			}
+		}
+	}
+
+	if top == nil {
+		sort.SliceStable(all, func(i, j int) bool {
+			return all[i].StartTime < all[j].StartTime
+		})
+
+		out := []*Chapter{}
+		for _, c := range all {
+			out = append(out, newChapter(c))
+		}
+
+		return out
+	}
+
+	return resolveToc(top, chaps, tocs, map[string]bool{top.ElementID: true})
+}
+
+// resolveToc will provide the entries of the table of contents, with seen guarding
+// against a table being listed within itself
+func resolveToc(t *frames.CTOC, chaps map[string]*frames.CHAP, tocs map[string]*frames.CTOC, seen map[string]bool) []*Chapter {
+	out := []*Chapter{}
+	for _, id := range t.Children {
+		if c, ok := chaps[id]; ok {
+			out = append(out, newChapter(c))
+			continue
+		}
+
+		x, ok := tocs[id]
+		if !ok || seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		c := &Chapter{
+			ID:          x.ElementID,
+			Title:       x.Title(),
+			Description: frameText(x.GetFrame("TIT3")),
+			StartOffset: frames.ChapterOffsetUnused,
+			EndOffset:   frames.ChapterOffsetUnused,
+			Chapters:    resolveToc(x, chaps, tocs, seen),
+			Frame:       x,
+		}
+		for i, v := range c.Chapters {
+			if i == 0 || v.StartTime < c.StartTime {
+				c.StartTime = v.StartTime
+			}
+			if v.EndTime > c.EndTime {
+				c.EndTime = v.EndTime
+			}
+		}
+
+		out = append(out, c)
+	}
+
+	return out
+}
+
+func newChapter(x *frames.CHAP) *Chapter {
+	c := &Chapter{
+		ID:          x.ElementID,
+		Title:       x.Title(),
+		Description: frameText(x.GetFrame("TIT3")),
+		StartTime:   x.StartTime,
+		EndTime:     x.EndTime,
+		StartOffset: x.StartOffset,
+		EndOffset:   x.EndOffset,
+		Chapters:    []*Chapter{},
+		Frame:       x,
+	}
+
+	if w, ok := x.GetFrame("WXXX").(*frames.WXXX); ok {
+		c.URL = w.URL
+	}
+	if p, ok := x.GetFrame("APIC").(frames.IPicture); ok {
+		c.Picture = p.GetPicture()
+	}
+
+	return c
+}
+
+func frameText(fr frames.IFrame) string {
+	if t, ok := fr.(*frames.TEXT); ok {
+		return t.Cleaned
+	}
+
+	return ""
+}
+
+// Chapters provides the chapter tree from the v2 tag
+func (f *File) Chapters() []*Chapter {
+	return f.V2.Chapters()
+}
@@ -0,0 +1,69 @@
+package id3
+
+import (
+	"testing"
+
+	"github.com/cloudcloud/go-id3/frames"
+	"github.com/stretchr/testify/assert"
+)
+
+const chapterTag = "ID3\x04\x00\x00\x00\x00\x01Y" +
+	"CTOC\x00\x00\x00\x1e\x00\x00toc\x00\x03\x02chp1\x00sub\x00TIT2\x00\x00\x00\x05\x00\x00\x00Book" +
+	"CHAP\x00\x00\x004\x00\x00chp2\x00\x00\x00\x03\xe8\x00\x00\x0b\xb8\xff\xff\xff\xff\xff\xff\xff\xff" +
+	"TIT2\x00\x00\x00\x04\x00\x00\x00TwoTIT3\x00\x00\x00\x07\x00\x00\x00Second" +
+	"CTOC\x00\x00\x00\x1e\x00\x00sub\x00\x01\x02chp2\x00toc\x00TIT2\x00\x00\x00\x05\x00\x00\x00Part" +
+	"CHAP\x00\x00\x00A\x00\x00chp1\x00\x00\x00\x00\x00\x00\x00\x03\xe8\xff\xff\xff\xff\xff\xff\xff\xff" +
+	"TIT2\x00\x00\x00\x04\x00\x00\x00OneWXXX\x00\x00\x00\x14\x00\x00\x00\x00http://example.com"
+
+func TestChapters(t *testing.T) {
+	assert := assert.New(t)
+	b := &tfile{}
+	_, _ = b.Write([]byte(chapterTag))
+	f := &File{V2: &V2{}}
+	assert.Nil(f.V2.Parse(b))
+
+	c := f.Chapters()
+	assert.Equal(2, len(c))
+
+	assert.Equal("chp1", c[0].ID)
+	assert.Equal("One", c[0].Title)
+	assert.Equal("http://example.com", c[0].URL)
+	assert.Equal(uint32(0), c[0].StartTime)
+	assert.Equal(uint32(1000), c[0].EndTime)
+	assert.Equal(frames.ChapterOffsetUnused, c[0].EndOffset)
+
+	// the nested table spans its entries, without listing the top level again
+	assert.Equal("sub", c[1].ID)
+	assert.Equal("Part", c[1].Title)
+	assert.Equal(uint32(1000), c[1].StartTime)
+	assert.Equal(uint32(3000), c[1].EndTime)
+	assert.Equal(1, len(c[1].Chapters))
+	assert.Equal("Two", c[1].Chapters[0].Title)
+	assert.Equal("Second", c[1].Chapters[0].Description)
+
+	m, err := f.V2.Marshal()
+	assert.Nil(err)
+	assert.Equal(chapterTag, string(m))
+}
+
+func TestChaptersWithoutToc(t *testing.T) {
+	assert := assert.New(t)
+	b := &tfile{}
+	_, _ = b.Write([]byte(chapterTag))
+	v := &V2{}
+	assert.Nil(v.Parse(b))
+
+	chaps := []frames.IFrame{}
+	for _, fr := range v.Frames {
+		if _, ok := fr.(*frames.CHAP); ok {
+			chaps = append(chaps, fr)
+		}
+	}
+	v.Frames = chaps
+
+	c := v.Chapters()
+	assert.Equal(2, len(c))
+	assert.Equal("chp1", c[0].ID)
+	assert.Equal("chp2", c[1].ID)
+	assert.Empty(c[1].Chapters)
+}
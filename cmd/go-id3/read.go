@@ -39,5 +39,9 @@ func readProcess(args []string, o io.Writer) {
 	}
 	defer handle.Close()
 
-	f.Process(handle).PrettyPrint(o, outFormat)
+	if _, err = f.Process(handle); err != nil {
+		fmt.Fprintf(os.Stderr, "Problem reading the file [%s], %s\n", f.Filename, err)
+	}
+
+	f.PrettyPrint(o, outFormat)
 }
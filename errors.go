@@ -0,0 +1,47 @@
+package id3
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cloudcloud/go-id3/frames"
+)
+
+var (
+	// ErrNoTag is given when a file holds no tag of the version being read
+	ErrNoTag = errors.New("no tag found")
+	// ErrTruncated is given when a tag or frame ends before the content it declares
+	ErrTruncated = frames.ErrTruncated
+	// ErrUnsupportedVersion is given for a tag with a major version that isn't known
+	ErrUnsupportedVersion = errors.New("unsupported version")
+	// ErrInvalidFrameID is given for a frame identifier made of more than A-Z and 0-9
+	ErrInvalidFrameID = errors.New("invalid frame identifier")
+	// ErrNotSynchsafe is given for a size that should be synchsafe, but is not
+	ErrNotSynchsafe = errors.New("size is not synchsafe")
+	// ErrTooLarge is given when a tag or frame exceeds the size allowed by ParseOptions
+	ErrTooLarge = frames.ErrTooLarge
+)
+
+// ParseError describes a problem found while parsing a tag, along with where it was
+// found. The cause can be matched with errors.Is, such as against ErrTruncated.
+type ParseError struct {
+	Offset  int64  `json:"offset"`
+	Frame   string `json:"frame"`
+	Version int    `json:"version"`
+	Err     error  `json:"-"`
+}
+
+// Error provides the description of the problem and its location
+func (e *ParseError) Error() string {
+	s := fmt.Sprintf("id3v2.%d", e.Version)
+	if len(e.Frame) > 0 {
+		s += fmt.Sprintf(" frame [%s]", e.Frame)
+	}
+
+	return fmt.Sprintf("%s at offset %d: %s", s, e.Offset, e.Err)
+}
+
+// Unwrap provides the underlying cause
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
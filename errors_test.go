@@ -0,0 +1,82 @@
+package id3
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/cloudcloud/go-id3/frames"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseError(t *testing.T) {
+	assert := assert.New(t)
+	e := &ParseError{Offset: 20, Frame: "TPE1", Version: 3, Err: ErrTruncated}
+
+	assert.Equal("id3v2.3 frame [TPE1] at offset 20: content is truncated", e.Error())
+	assert.True(errors.Is(e, ErrTruncated))
+
+	e = &ParseError{Version: 9, Err: ErrUnsupportedVersion}
+	assert.Equal("id3v2.9 at offset 0: unsupported version", e.Error())
+}
+
+func TestParseErrors(t *testing.T) {
+	assert := assert.New(t)
+	cases := []struct {
+		tag    string
+		err    error
+		frame  string
+		offset int64
+		frames int
+	}{
+		{"not a tag at all", ErrNoTag, "", 0, 0},
+		{"ID3\x05\x00\x00\x00\x00\x00\x00", ErrUnsupportedVersion, "", 10, 0},
+		{
+			"ID3\x03\x00\x00\x00\x00\x00\x26" +
+				"TPE1\x00\x00\x00\x0d\x00\x00\x00Cult of Luna" +
+				"TIT2\x00\x00\x00\x20\x00\x00\x00Short",
+			ErrTruncated, "TIT2", 33, 1,
+		},
+		{
+			"ID3\x04\x00\x00\x00\x00\x00\x23" +
+				"SEEK\x00\x00\x00\x02\x00\x00\x00\x01" +
+				"TPE1\x00\x00\x00\x0d\x00\x00\x00Cult of Luna",
+			ErrTruncated, "SEEK", 10, 2,
+		},
+	}
+
+	for _, c := range cases {
+		b := &tfile{}
+		_, _ = b.Write([]byte(c.tag))
+		v := &V2{}
+		err := v.Parse(b)
+
+		assert.True(errors.Is(err, c.err), "%s", err)
+		assert.Equal(c.frames, len(v.Frames))
+
+		var pe *ParseError
+		if c.err != ErrNoTag {
+			assert.True(errors.As(err, &pe))
+			assert.Equal(c.frame, pe.Frame)
+			assert.Equal(c.offset, pe.Offset)
+		}
+	}
+}
+
+func TestProcessErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	b := &tfile{}
+	_, _ = b.Write([]byte("nothing to see here"))
+	f, err := (&File{}).Process(b)
+	assert.True(errors.Is(err, ErrNoTag))
+	assert.Equal(0, f.V2.Major)
+
+	// enough content follows for the v1 check to be passed over
+	b = &tfile{}
+	_, _ = b.Write([]byte("ID3\x03\x00\x00\x00\x00\x00\x0e" + "TPE1\x00\x00\x00\x0d\x00\x00" + strings.Repeat("\x00", 128)))
+	_, err = (&File{}).Process(b)
+	var pe *ParseError
+	assert.True(errors.As(err, &pe))
+	assert.Equal(frames.Version3, pe.Version)
+}
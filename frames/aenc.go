@@ -33,16 +33,28 @@ func (a *AENC) ProcessData(l int, b []byte) IFrame {
 	// <text string> \x00 \xXX \xXX \xXX \xXX <binary data>
 	term := bytes.IndexByte(b, '\x00')
 	if term == 0 {
-		fmt.Println("This file is encrypted and has no decryption detail")
+		a.fail(ErrInvalid, "has no owner identifier")
 		return a
 	}
 
-	a.Contact = GetStr(b[:term])
+	if term < 0 || len(b) < term+5 {
+		a.fail(ErrTruncated, "preview details are missing")
+		return a
+	}
+
+	a.Contact = GetLatin1(b[:term])
 	a.PreviewStart = b[term+1 : term+3]
 	a.PreviewLength = b[term+3 : term+5]
 	a.Encryption = b[term+5:]
 
-	fmt.Println("AENC is untested and possibly wrong")
-
 	return a
 }
+
+// Marshal will serialise the frame content for writing
+func (a *AENC) Marshal() []byte {
+	b := PutTermStr(a.Contact, EncodingLatin1)
+	b = append(b, a.PreviewStart...)
+	b = append(b, a.PreviewLength...)
+
+	return append(b, a.Encryption...)
+}
@@ -38,3 +38,7 @@ func TestAencBasicOutput(t *testing.T) {
 		t.Error("Invalid AENC ProcessData() result")
 	}
 }
+
+func FuzzAencProcess(f *testing.F) {
+	fuzzFrame(f, "AENC", "owner\x00\x00\x01\x00\x02data")
+}
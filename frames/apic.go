@@ -9,34 +9,80 @@ import (
 type APIC struct {
 	Frame
 
-	MimeType    string `json:"mime_type"`
-	PictureType string `json:"picture_type"`
-	Image       []byte `json:"image" yaml:"image"`
-	Title       string `json:"title"`
+	MimeType     string      `json:"mime_type"`
+	PictureType  PictureType `json:"picture_type"`
+	PictureLabel string      `json:"picture_label" yaml:"picture_label"`
+	Image        []byte      `json:"image" yaml:"image"`
+	Title        string      `json:"title"`
 }
 
-var picList = map[int]string{
-	0:  "Other",
-	1:  "32x32 pixels 'file icon' (PNG only)",
-	2:  "Other file icon",
-	3:  "Cover (front)",
-	4:  "Cover (back)",
-	5:  "Leaflet page",
-	6:  "Media (e.g. lable side of CD)",
-	7:  "Lead artist/lead performer/soloist",
-	8:  "Artist/performer",
-	9:  "Conductor",
-	10: "Band/Orchestra",
-	11: "Composer",
-	12: "Lyricist/text writer",
-	13: "Recording Location",
-	14: "During recording",
-	15: "During performance",
-	16: "Movie/video screen capture",
-	17: "A bright coloured fish",
-	18: "Illustration",
-	19: "Band/artist logotype",
-	20: "Publisher/Studio logotype",
+// PictureType is the kind of picture held, given as the code found within the frame.
+// Codes beyond those defined are kept as they are, though labelled as "Other".
+type PictureType byte
+
+// Picture types defined by the spec
+const (
+	PictureOther PictureType = iota
+	PictureFileIcon
+	PictureOtherFileIcon
+	PictureCoverFront
+	PictureCoverBack
+	PictureLeaflet
+	PictureMedia
+	PictureLeadArtist
+	PictureArtist
+	PictureConductor
+	PictureBand
+	PictureComposer
+	PictureLyricist
+	PictureRecordingLocation
+	PictureDuringRecording
+	PictureDuringPerformance
+	PictureScreenCapture
+	PictureBrightFish
+	PictureIllustration
+	PictureBandLogo
+	PicturePublisherLogo
+)
+
+var picList = map[PictureType]string{
+	PictureOther:             "Other",
+	PictureFileIcon:          "32x32 pixels 'file icon' (PNG only)",
+	PictureOtherFileIcon:     "Other file icon",
+	PictureCoverFront:        "Cover (front)",
+	PictureCoverBack:         "Cover (back)",
+	PictureLeaflet:           "Leaflet page",
+	PictureMedia:             "Media (e.g. lable side of CD)",
+	PictureLeadArtist:        "Lead artist/lead performer/soloist",
+	PictureArtist:            "Artist/performer",
+	PictureConductor:         "Conductor",
+	PictureBand:              "Band/Orchestra",
+	PictureComposer:          "Composer",
+	PictureLyricist:          "Lyricist/text writer",
+	PictureRecordingLocation: "Recording Location",
+	PictureDuringRecording:   "During recording",
+	PictureDuringPerformance: "During performance",
+	PictureScreenCapture:     "Movie/video screen capture",
+	PictureBrightFish:        "A bright coloured fish",
+	PictureIllustration:      "Illustration",
+	PictureBandLogo:          "Band/artist logotype",
+	PicturePublisherLogo:     "Publisher/Studio logotype",
+}
+
+// String provides the label for the picture type
+func (p PictureType) String() string {
+	if l, ok := picList[p]; ok {
+		return l
+	}
+
+	return picList[PictureOther]
+}
+
+// Known determines if the picture type is one defined by the spec
+func (p PictureType) Known() bool {
+	_, ok := picList[p]
+
+	return ok
 }
 
 // DisplayContent will comprehensively display known information for APIC
@@ -49,38 +95,51 @@ func (a *APIC) ProcessData(s int, d []byte) IFrame {
 	a.Size = s
 	a.Data = d
 
-	// encoding is first, 1 is unicode
-	if d[0] == '\x01' {
-		a.Utf16 = true
+	if len(d) < 1 {
+		a.fail(ErrTruncated, "has no content")
+		return a
 	}
+
+	// encoding is first
+	a.Encoding = GetEncoding(d[0])
 	d = d[1:]
 
 	// mime type next, null term
 	idx := bytes.IndexByte(d, '\x00')
-	a.MimeType = GetStr(d[:idx])
+	if idx < 0 || len(d) < idx+2 {
+		a.fail(ErrTruncated, "picture type is missing")
+		return a
+	}
+	a.MimeType = GetLatin1(d[:idx])
 
 	// picture type
-	pic := GetSize([]byte{d[idx+1]}, 8)
-	a.PictureType = picList[pic]
-	if len(a.PictureType) < 2 {
-		a.PictureType = picList[0]
-	}
+	a.SetPictureType(PictureType(d[idx+1]))
 	d = d[idx+2:]
 
-	// image description, null term
-	if !a.Utf16 {
-		idx = bytes.IndexByte(d, '\x00')
-		a.Title = GetStr(d[:idx])
+	// image description, null term, with the image following
+	a.Title, a.Image = GetTermStr(a.Encoding, d)
+	a.Size = len(a.Image)
 
-		// image is next now
-		a.Image = d[idx+1:]
-	} else {
-		idx = bytes.Index(d, []byte{'\x00', '\x00'})
-		a.Title = GetUnicodeStr(d[:idx])
+	return a
+}
 
-		a.Image = d[idx+2:]
-	}
-	a.Size = len(a.Image)
+// Marshal will serialise the frame content for writing
+func (a *APIC) Marshal() []byte {
+	b := []byte{a.Encoding}
+	b = append(b, PutTermStr(a.MimeType, EncodingLatin1)...)
+	b = append(b, byte(a.PictureType))
+	b = append(b, PutTermStr(a.Title, a.Encoding)...)
+
+	return append(b, a.Image...)
+}
 
+// GetPicture provides the picture held by the frame
+func (a *APIC) GetPicture() *APIC {
 	return a
 }
+
+// SetPictureType will set the picture type, along with the label derived from it
+func (a *APIC) SetPictureType(p PictureType) {
+	a.PictureType = p
+	a.PictureLabel = p.String()
+}
@@ -1,6 +1,10 @@
 package frames
 
-import "testing"
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
 
 func TestApicBasicOutput(t *testing.T) {
 	x := NewFrame("APIC", "Attached picture", Version3).(*APIC)
@@ -54,4 +58,48 @@ func TestApicInvalidPicType(t *testing.T) {
 	if found != expected {
 		t.Errorf("Got [%s], Expected [%s]", found, expected)
 	}
+
+	if x.PictureType != 0x22 || x.PictureType.Known() {
+		t.Fatalf("Got [%#x], Expected [0x22] to be kept as unknown", byte(x.PictureType))
+	}
+
+	if m := string(x.Marshal()); m != string(b) {
+		t.Fatalf("Got [%#v], Expected [%#v]", m, string(b))
+	}
+}
+
+func TestApicPictureType(t *testing.T) {
+	x := NewFrame("APIC", "", Version3).(*APIC)
+	b := []byte("\x00image/jpeg\x00\x03\x00\xff\xd8")
+
+	x.ProcessData(len(b), b)
+	if x.PictureType != PictureCoverFront || x.PictureLabel != "Cover (front)" {
+		t.Fatalf("Got [%d, %s], Expected [%d, Cover (front)]", x.PictureType, x.PictureLabel, PictureCoverFront)
+	}
+
+	out, _ := json.Marshal(x)
+	expected := `"picture_type":3,"picture_label":"Cover (front)"`
+	if !strings.Contains(string(out), expected) {
+		t.Fatalf("Got [%s], Expected to contain [%s]", out, expected)
+	}
+
+	x.SetPictureType(PictureBandLogo)
+	if x.PictureLabel != "Band/artist logotype" || x.Marshal()[12] != 19 {
+		t.Fatalf("Got [%s, %d], Expected [Band/artist logotype, 19]", x.PictureLabel, x.Marshal()[12])
+	}
+}
+
+func TestApicLatin1(t *testing.T) {
+	x := NewFrame("APIC", "", Version3).(*APIC)
+	b := []byte("\x00image/png\x00\x03Pochette \xe0 l'endroit\x00\x01\x02\x03")
+
+	x.ProcessData(len(b), b)
+	expected := "Pochette à l'endroit"
+	if x.Title != expected {
+		t.Fatalf("Got [%s], Expected [%s]", x.Title, expected)
+	}
+}
+
+func FuzzApicProcess(f *testing.F) {
+	fuzzFrame(f, "APIC", "\x00image/png\x00\x03title\x00\x89PNG", "\x01image/jpeg\x00\x00\xff\xfet\x00\x00\x00\xff\xd8")
 }
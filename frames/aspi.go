@@ -1,9 +1,8 @@
 package frames
 
-import (
-	"fmt"
-	"os"
-)
+import "fmt"
+
+const aspiHeaderLength = 11
 
 // ASPI defines structure for a seek point index within the audio
 type ASPI struct {
@@ -26,7 +25,8 @@ func (a *ASPI) ProcessData(s int, d []byte) IFrame {
 	a.Size = s
 	a.Data = d
 
-	if len(a.Data) < 12 {
+	if len(d) < aspiHeaderLength {
+		a.fail(ErrTruncated, "requires %d bytes, found [%d]", aspiHeaderLength, len(d))
 		return a
 	}
 	a.Start = GetSize(d[:4], 8)
@@ -41,19 +41,23 @@ func (a *ASPI) ProcessData(s int, d []byte) IFrame {
 	a.Bits = GetSize([]byte{d[0]}, 8)
 	d = d[1:]
 
-	expect := a.Number * (a.Bits / 8)
-	found := len(d) / (a.Bits / 8)
-	if expect != a.Number || found != a.Number {
-		// well, this is awkward...
-		fmt.Fprintf(os.Stderr, "ASPI Frame is configured incorrectly, expected [%d], got [%d]\n", expect, found)
-
-		a.Start = 0
-		a.Length = 0
-		a.Number = 0
-
-		return a
-	}
+	// each fraction is held in either 8 or 16 bits, with the content kept as found
+	// when it doesn't match so that it is written back unchanged
 	a.FractionData = d
+	width := a.Bits / 8
+	if len(d) != a.Number*width || (a.Number > 0 && width < 1) {
+		a.fail(ErrInvalid, "expected [%d] points of [%d] bits, found [%d] bytes", a.Number, a.Bits, len(d))
+	}
 
 	return a
 }
+
+// Marshal will serialise the frame content for writing
+func (a *ASPI) Marshal() []byte {
+	b := PutSize(a.Start, 4, 8)
+	b = append(b, PutSize(a.Length, 4, 8)...)
+	b = append(b, PutSize(a.Number, 2, 8)...)
+	b = append(b, byte(a.Bits))
+
+	return append(b, a.FractionData...)
+}
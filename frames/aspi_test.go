@@ -1,6 +1,10 @@
 package frames
 
-import "testing"
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
 
 func TestAspiBasic(t *testing.T) {
 	x := NewFrame("ASPI", "Audio seek point", Version4).(*ASPI)
@@ -43,7 +47,9 @@ func TestAspiFailure(t *testing.T) {
 	x := NewFrame("ASPI", "", Version4).(*ASPI)
 	b := []byte("\x01\x02\x03")
 
-	x.ProcessData(len(b), b)
+	if _, err := Process(x, len(b), b); !errors.Is(err, ErrTruncated) {
+		t.Fatalf("Got [%v], Expected [%s]", err, ErrTruncated)
+	}
 
 	expected := "Seek Points (0) [0:0]\n"
 	found := x.DisplayContent()
@@ -55,11 +61,38 @@ func TestAspiFailure(t *testing.T) {
 func TestAspiAltFailure(t *testing.T) {
 	x := NewFrame("ASPI", "", Version4).(*ASPI)
 	b := []byte("\x00\x00\x00\x35\x00\x00\x35\x00\x00\x27\x08\x03")
-	x.ProcessData(len(b), b)
+	if _, err := Process(x, len(b), b); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("Got [%v], Expected [%s]", err, ErrInvalid)
+	}
 
-	expected := "Seek Points (0) [0:0]\n"
+	// the parsed values are kept, so the content is written back unchanged
+	expected := "Seek Points (39) [53:13568]\n"
+	found := x.DisplayContent()
+	if found != expected {
+		t.Errorf("Got [%s], Expected [%s]", found, expected)
+	}
+	if m := x.Marshal(); !bytes.Equal(m, b) {
+		t.Errorf("Got [%#v], Expected [%#v]", m, b)
+	}
+}
+
+func TestAspiNoPoints(t *testing.T) {
+	x := NewFrame("ASPI", "", Version4).(*ASPI)
+	b := []byte("\x00\x00\x00\x35\x00\x00\x35\x00\x00\x00\x08")
+	if _, err := Process(x, len(b), b); err != nil {
+		t.Fatalf("Got [%s], Expected no error", err)
+	}
+
+	expected := "Seek Points (0) [53:13568]\n"
 	found := x.DisplayContent()
 	if found != expected {
 		t.Errorf("Got [%s], Expected [%s]", found, expected)
 	}
+	if m := x.Marshal(); !bytes.Equal(m, b) {
+		t.Errorf("Got [%#v], Expected [%#v]", m, b)
+	}
+}
+
+func FuzzAspiProcess(f *testing.F) {
+	fuzzFrame(f, "ASPI", "\x00\x00\x00\x01\x00\x00\x00\x02\x00\x02\x08\x01\x02")
 }
@@ -0,0 +1,187 @@
+package frames
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// ChapterOffsetUnused marks a chapter byte offset that isn't given, leaving the
+// times to be used instead
+const ChapterOffsetUnused uint32 = 0xFFFFFFFF
+
+// CHAP is a chapter frame from the chapter addendum, marking a section of the audio
+// by time and optionally by byte offset. Frames such as TIT2, TIT3, WXXX and APIC
+// are embedded to describe the chapter.
+type CHAP struct {
+	Frame
+
+	ElementID   string   `json:"element_id" yaml:"element_id"`
+	StartTime   uint32   `json:"start_time" yaml:"start_time"`
+	EndTime     uint32   `json:"end_time" yaml:"end_time"`
+	StartOffset uint32   `json:"start_offset" yaml:"start_offset"`
+	EndOffset   uint32   `json:"end_offset" yaml:"end_offset"`
+	Frames      []IFrame `json:"frames"`
+}
+
+// DisplayContent will comprehensively display known information
+func (c *CHAP) DisplayContent() string {
+	str := fmt.Sprintf("Chapter [%s] %dms to %dms\n", c.ElementID, c.StartTime, c.EndTime)
+	for _, v := range c.Frames {
+		str = fmt.Sprintf("%s\t%s", str, v.DisplayContent())
+	}
+
+	return str
+}
+
+// ProcessData will handle the acquisition of all data
+func (c *CHAP) ProcessData(s int, d []byte) IFrame {
+	c.Size = s
+	c.Data = d
+	c.Frames = []IFrame{}
+
+	c.ElementID, d = GetTermStr(EncodingLatin1, d)
+	if len(d) < 16 {
+		c.fail(ErrTruncated, "times and offsets are missing")
+		return c
+	}
+
+	c.StartTime = binary.BigEndian.Uint32(d[:4])
+	c.EndTime = binary.BigEndian.Uint32(d[4:8])
+	c.StartOffset = binary.BigEndian.Uint32(d[8:12])
+	c.EndOffset = binary.BigEndian.Uint32(d[12:16])
+
+	var err error
+	if c.Frames, err = readFrames(c.Version, d[16:]); err != nil {
+		c.fail(ErrTruncated, "embedded frames, %s", err)
+	}
+
+	return c
+}
+
+// Marshal will serialise the frame content for writing
+func (c *CHAP) Marshal() []byte {
+	b := PutTermStr(c.ElementID, EncodingLatin1)
+	b = binary.BigEndian.AppendUint32(b, c.StartTime)
+	b = binary.BigEndian.AppendUint32(b, c.EndTime)
+	b = binary.BigEndian.AppendUint32(b, c.StartOffset)
+	b = binary.BigEndian.AppendUint32(b, c.EndOffset)
+
+	return append(b, putFrames(c.Version, c.Frames)...)
+}
+
+// GetFrame provides the first embedded frame with the name, or nil when none exist
+func (c *CHAP) GetFrame(n string) IFrame {
+	return findFrame(c.Frames, n)
+}
+
+// Title provides the chapter title from the embedded TIT2 frame
+func (c *CHAP) Title() string {
+	return findText(c.Frames, "TIT2")
+}
+
+// readFrames will process frames embedded within another, laid out as they would be
+// within a tag of the version. Frames without a decoder, or with content that can't
+// be processed, are kept as unknown frames so their content isn't lost.
+func readFrames(version int, d []byte) ([]IFrame, error) {
+	out := []IFrame{}
+	sig := uint(8)
+	if version == Version4 {
+		sig = 7
+	}
+
+	for len(d) > 0 && d[0] != 0 {
+		if len(d) < 10 {
+			return out, fmt.Errorf("frame header requires 10 bytes, found [%d]", len(d))
+		}
+
+		name := string(d[:4])
+		size := GetSize(d[4:8], sig)
+		flags := d[8:10]
+		d = d[10:]
+		if size > len(d) {
+			return out, fmt.Errorf("frame [%s] requires [%d] bytes, found [%d]", name, size, len(d))
+		}
+
+		raw := d[:size]
+		d = d[size:]
+
+		// chapter frames aren't decoded within one another, keeping nesting shallow,
+		// and encrypted content is kept as it was found
+		var fr IFrame
+		if g, ok := Lookup(name, version); ok && name != "CHAP" && name != "CTOC" {
+			fr = g()
+			fr.GetBase().ProcessFlags(version, flags)
+			if !fr.GetBase().Encryption {
+				content, err := fr.GetBase().ProcessHeaderData(version, raw, 0)
+				if err == nil {
+					_, err = Process(fr, len(content), content)
+				}
+				if err == nil {
+					out = append(out, fr)
+					continue
+				}
+			}
+		}
+
+		fr = NewUnknown(name, version)
+		fr.GetBase().ProcessFlags(version, flags)
+		out = append(out, fr.ProcessData(len(raw), raw))
+	}
+
+	return out, nil
+}
+
+// putFrames will serialise embedded frames, with headers laid out for the version.
+// Unknown frames are written as they were found, with their own flags, other than
+// those relying upon the flags of another version, which are left out.
+func putFrames(version int, fr []IFrame) []byte {
+	out := []byte{}
+	sig := uint(8)
+	if version == Version4 {
+		sig = 7
+	}
+
+	for _, v := range fr {
+		base := v.GetBase()
+		u, unknown := v.(*UNKNOWN)
+		if unknown && u.Version != version && u.Transformed() {
+			continue
+		}
+		if !unknown {
+			base.Version = version
+		}
+
+		d := v.Marshal()
+		flags := base.Flags
+		if !unknown || u.Version != version {
+			flags, d = base.PutFlags(version, false, base.Compression && !base.Encryption, d)
+		}
+
+		out = append(out, base.Name...)
+		out = append(out, PutSize(len(d), 4, sig)...)
+		out = append(out, PutSize(flags, 2, 8)...)
+		out = append(out, d...)
+	}
+
+	return out
+}
+
+// findFrame provides the first frame with the name, or nil when none exist
+func findFrame(fr []IFrame, n string) IFrame {
+	for _, v := range fr {
+		if v.GetName() == n {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// findText provides the text of the first frame with the name
+func findText(fr []IFrame, n string) string {
+	if t, ok := findFrame(fr, n).(*TEXT); ok {
+		return t.Cleaned
+	}
+
+	return ""
+}
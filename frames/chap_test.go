@@ -0,0 +1,83 @@
+package frames
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestChapProcess(t *testing.T) {
+	b := []byte("chp0\x00\x00\x00\x00\x00\x00\x00\x13\x88\xff\xff\xff\xff\xff\xff\xff\xff" +
+		"TIT2\x00\x00\x00\x06\x00\x00\x00Intro" +
+		"WXXX\x00\x00\x00\x14\x00\x00\x00\x00http://example.com" +
+		"XYZW\x00\x00\x00\x02\x00\x00\x01\x02")
+	x := NewFrame("CHAP", "", Version4).(*CHAP)
+	if _, err := Process(x, len(b), b); err != nil {
+		t.Fatalf("Got [%s], Expected no error", err)
+	}
+
+	if x.ElementID != "chp0" || x.StartTime != 0 || x.EndTime != 5000 {
+		t.Fatalf("Got [%s] [%d] [%d], Expected [chp0] [0] [5000]", x.ElementID, x.StartTime, x.EndTime)
+	}
+	if x.StartOffset != ChapterOffsetUnused || x.EndOffset != ChapterOffsetUnused {
+		t.Fatalf("Got [%d] [%d], Expected unused offsets", x.StartOffset, x.EndOffset)
+	}
+
+	expected := "Intro"
+	found := x.Title()
+	if found != expected {
+		t.Fatalf("Got [%s], Expected [%s]", found, expected)
+	}
+
+	if w, ok := x.GetFrame("WXXX").(*WXXX); !ok || w.URL != "http://example.com" {
+		t.Fatalf("Expected the embedded WXXX frame, got [%#v]", x.GetFrame("WXXX"))
+	}
+	if _, ok := x.GetFrame("XYZW").(*UNKNOWN); !ok {
+		t.Fatalf("Expected the embedded XYZW frame to be kept as unknown")
+	}
+
+	expected = "Chapter [chp0] 0ms to 5000ms\n\t[TIT2 - 6] (Title/songname/content description) Intro\n"
+	found = x.DisplayContent()[:len(expected)]
+	if found != expected {
+		t.Fatalf("Got [%s], Expected [%s]", found, expected)
+	}
+
+	if !bytes.Equal(x.Marshal(), b) {
+		t.Fatalf("Got [%#v], Expected [%#v]", x.Marshal(), b)
+	}
+}
+
+func TestChapEncryptedFrame(t *testing.T) {
+	b := []byte("chp0\x00\x00\x00\x00\x00\x00\x00\x13\x88\xff\xff\xff\xff\xff\xff\xff\xff" +
+		"TIT2\x00\x00\x00\x04\x00\x04\x80\x01\x02\x03")
+	x := NewFrame("CHAP", "", Version4).(*CHAP)
+	if _, err := Process(x, len(b), b); err != nil {
+		t.Fatalf("Got [%s], Expected no error", err)
+	}
+
+	if u, ok := x.GetFrame("TIT2").(*UNKNOWN); !ok || !u.Encryption {
+		t.Fatalf("Expected the encrypted TIT2 frame to be kept as unknown, got [%#v]", x.GetFrame("TIT2"))
+	}
+
+	if !bytes.Equal(x.Marshal(), b) {
+		t.Fatalf("Got [%#v], Expected [%#v]", x.Marshal(), b)
+	}
+}
+
+func TestChapTruncated(t *testing.T) {
+	cases := []string{
+		"chp0\x00\x00\x00\x00\x00",
+		"chp0\x00\x00\x00\x00\x00\x00\x00\x13\x88\xff\xff\xff\xff\xff\xff\xff\xffTIT2\x00\x00\x00\x10\x00\x00\x00Intro",
+	}
+
+	for _, c := range cases {
+		x := NewFrame("CHAP", "", Version4)
+		if _, err := Process(x, len(c), []byte(c)); !errors.Is(err, ErrTruncated) {
+			t.Fatalf("Got [%v], Expected [%v]", err, ErrTruncated)
+		}
+	}
+}
+
+func FuzzChapProcess(f *testing.F) {
+	fuzzFrame(f, "CHAP", "chp0\x00\x00\x00\x00\x00\x00\x00\x13\x88\xff\xff\xff\xff\xff\xff\xff\xffTIT2\x00\x00\x00\x06\x00\x00\x00Intro")
+}
@@ -1,9 +1,6 @@
 package frames
 
-import (
-	"bytes"
-	"fmt"
-)
+import "fmt"
 
 // COMM contains the processing house for Comments
 type COMM struct {
@@ -24,26 +21,24 @@ func (c *COMM) ProcessData(s int, d []byte) IFrame {
 	c.Size = s
 	c.Data = d
 
-	// text encoding is a single byte, 0 for latin, 1 for unicode
+	// text encoding is a single byte
 	if len(d) > 4 {
-		enc := d[0]
-		c.Language = GetStr(d[1:4])
+		c.Encoding = GetEncoding(d[0])
+		c.Language = GetLatin1(d[1:4])
 		d = d[4:]
 
-		switch enc {
-		case '\x00':
-			idx := bytes.IndexByte(d, '\x00')
-			c.ContentDescription = GetStr(d[:idx])
-			c.Comment = GetStr(d[idx+1:])
-
-		case '\x01':
-			c.Utf16 = true
-
-			idx := bytes.Index(d, []byte{'\x00', '\x00'})
-			c.ContentDescription = GetUnicodeStr(d[:idx])
-			c.Comment = GetUnicodeStr(d[idx+2:])
-		}
+		c.ContentDescription, d = GetTermStr(c.Encoding, d)
+		c.Comment = GetEncStr(c.Encoding, d)
 	}
 
 	return c
 }
+
+// Marshal will serialise the frame content for writing
+func (c *COMM) Marshal() []byte {
+	b := []byte{c.Encoding}
+	b = append(b, putFixed(c.Language, 3)...)
+	b = append(b, PutTermStr(c.ContentDescription, c.Encoding)...)
+
+	return append(b, PutStr(c.Comment, c.Encoding)...)
+}
@@ -36,10 +36,44 @@ func TestCommBasicOutput(t *testing.T) {
 	b = []byte("\x01eng\xff\xfe\xe4\xba\x88\xe8\xa5\xb2\xe5\xbe\xa9\xe8\xae\x90\x00\x00" +
 		"\xff\xfe\xe4\xba\x88\xe8\xa5\xb2\xe5\xbe\xa9\xe8\xae\x90")
 	x.ProcessData(len(b), b)
-	if x.Language != "eng" || x.Utf16 != true {
+	if x.Language != "eng" || x.Encoding != EncodingUTF16 {
 		t.Fatal("Invalid COMM basic Utf16 parsing")
 	}
 	if x.GetLength() != 34 {
 		t.Fatal("Invalid COMM string Utf16 get")
 	}
 }
+
+func TestCommLatin1(t *testing.T) {
+	x := NewFrame("COMM", "", Version3).(*COMM)
+	b := []byte("\x00engCaf\xe9\x00Cr\xe8me br\xfbl\xe9e")
+
+	x.ProcessData(len(b), b)
+	if x.ContentDescription != "Café" || x.Comment != "Crème brûlée" {
+		t.Fatalf("Got [%s] [%s], Expected [Café] [Crème brûlée]", x.ContentDescription, x.Comment)
+	}
+}
+
+func TestCommUtf16LittleEndian(t *testing.T) {
+	x := NewFrame("COMM", "", Version3).(*COMM)
+	b := []byte("\x01eng\xff\xfeA\x00\x00\x00\xff\xfeB\x00c\x00")
+
+	x.ProcessData(len(b), b)
+	if x.ContentDescription != "A" || x.Comment != "Bc" {
+		t.Fatalf("Got [%s] [%s], Expected [A] [Bc]", x.ContentDescription, x.Comment)
+	}
+}
+
+func TestCommUtf8(t *testing.T) {
+	x := NewFrame("COMM", "", Version4).(*COMM)
+	b := []byte("\x03eng\xc3\xa9t\xc3\xa9\x00\xe6\x97\xa5\xe6\x9c\xac")
+
+	x.ProcessData(len(b), b)
+	if x.ContentDescription != "été" || x.Comment != "日本" {
+		t.Fatalf("Got [%s] [%s], Expected [été] [日本]", x.ContentDescription, x.Comment)
+	}
+}
+
+func FuzzCommProcess(f *testing.F) {
+	fuzzFrame(f, "COMM", "\x00engdesc\x00comment", "\x01eng\xff\xfed\x00\x00\x00\xff\xfec\x00")
+}
@@ -1,9 +1,6 @@
 package frames
 
-import (
-	"bytes"
-	"fmt"
-)
+import "fmt"
 
 // COMR contains commercial details
 type COMR struct {
@@ -35,55 +32,53 @@ func (c *COMR) ProcessData(s int, d []byte) IFrame {
 	c.Size = s
 	c.Data = d
 
-	// text encoding is a single byte, 0 for latin, 1 for unicode
+	// text encoding is a single byte
 	if len(d) > 2 {
-		if d[0] == '\x01' {
-			c.Utf16 = true
-		}
+		c.Encoding = GetEncoding(d[0])
 
 		// pricing up first, null term
-		idx := bytes.IndexByte(d[1:], '\x00')
-		c.Price = GetStr(d[1:idx])
-		d = d[idx+2:]
+		c.Price, d = GetTermStr(EncodingLatin1, d[1:])
 
 		// valid until date is 8 bytes
-		c.ValidUntil = GetStr(d[:8]) // date: YYYYMMDD
+		if len(d) < 8 {
+			c.fail(ErrTruncated, "valid until date is missing")
+			return c
+		}
+		c.ValidUntil = GetLatin1(d[:8]) // date: YYYYMMDD
 		d = d[8:]
 
 		// contact url next, null term
-		idx = bytes.IndexByte(d, '\x00')
-		c.ContactURL = GetStr(d[:idx])
+		c.ContactURL, d = GetTermStr(EncodingLatin1, d)
 
 		// received as is method of song reception, single byte
-		c.ReceivedAs = d[idx+1]
-		d = d[idx+2:]
-
-		// seller name, null term
-		if !c.Utf16 {
-			idx = bytes.IndexByte(d, '\x00')
-			c.SellerName = GetStr(d[:idx])
-			d = d[idx+1:]
-
-			idx = bytes.IndexByte(d, '\x00')
-			c.CommercialName = GetStr(d[:idx])
-			d = d[idx+1:]
-		} else {
-			idx = bytes.Index(d, []byte{'\x00', '\x00'})
-			c.SellerName = GetUnicodeStr(d[:idx])
-			d = d[idx+2:]
-
-			idx = bytes.Index(d, []byte{'\x00', '\x00'})
-			c.CommercialName = GetUnicodeStr(d[:idx])
-			d = d[idx+2:]
+		if len(d) < 1 {
+			c.fail(ErrTruncated, "received as is missing")
+			return c
 		}
+		c.ReceivedAs = d[0]
+		d = d[1:]
 
-		// media mime, null term
-		idx = bytes.IndexByte(d, '\x00')
-		c.PictureMime = GetStr(d[:idx])
+		// seller and commercial names, null term
+		c.SellerName, d = GetTermStr(c.Encoding, d)
+		c.CommercialName, d = GetTermStr(c.Encoding, d)
 
-		// media binary data
-		c.Logo = d[idx+1:]
+		// media mime, null term, with the binary data following
+		c.PictureMime, c.Logo = GetTermStr(EncodingLatin1, d)
 	}
 
 	return c
 }
+
+// Marshal will serialise the frame content for writing
+func (c *COMR) Marshal() []byte {
+	b := []byte{c.Encoding}
+	b = append(b, PutTermStr(c.Price, EncodingLatin1)...)
+	b = append(b, putFixed(c.ValidUntil, 8)...)
+	b = append(b, PutTermStr(c.ContactURL, EncodingLatin1)...)
+	b = append(b, c.ReceivedAs)
+	b = append(b, PutTermStr(c.SellerName, c.Encoding)...)
+	b = append(b, PutTermStr(c.CommercialName, c.Encoding)...)
+	b = append(b, PutTermStr(c.PictureMime, EncodingLatin1)...)
+
+	return append(b, c.Logo...)
+}
@@ -24,7 +24,7 @@ func TestComrProcess(t *testing.T) {
 
 	x.ProcessData(len(b), b)
 
-	expected := `Price:           aud888.8
+	expected := `Price:           aud888.88
 Valid Until:     20200101
 Contact URL:     http://example.com
 Seller Name:     Bob
@@ -43,7 +43,7 @@ func TestComrProcessUtf16(t *testing.T) {
 
 	x.ProcessData(len(b), b)
 
-	expected := `Price:           aud888.8
+	expected := `Price:           aud888.88
 Valid Until:     20200101
 Contact URL:     http://example.com
 Seller Name:     Bob
@@ -54,3 +54,7 @@ Mime Type:       image/jpeg`
 		t.Errorf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func FuzzComrProcess(f *testing.F) {
+	fuzzFrame(f, "COMR", "\x00USD1.00\x0020201231http://x\x00\x01seller\x00name\x00image/png\x00logo")
+}
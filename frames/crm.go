@@ -1,9 +1,6 @@
 package frames
 
-import (
-	"bytes"
-	"fmt"
-)
+import "fmt"
 
 // CRM provides legacy meta encryption store
 type CRM struct {
@@ -24,34 +21,26 @@ func (c *CRM) ProcessData(s int, d []byte) IFrame {
 	c.Size = s
 	c.Data = d
 
-	c.Utf16 = false
-	if d[0] == '\x01' {
-		c.Utf16 = true
+	if len(d) < 1 {
+		c.fail(ErrTruncated, "has no content")
+		return c
 	}
-	d = d[1:]
-
-	term := []byte{'\x00'}
-	if c.Utf16 {
-		term = append(term, '\x00')
-	}
-
-	idx := bytes.Index(d, term)
-	if c.Utf16 {
-		c.Owner = GetUnicodeStr(d[:idx])
-	} else {
-		c.Owner = GetStr(d[:idx])
-	}
-	d = d[idx+len(term):]
 
-	idx = bytes.Index(d, term)
-	if c.Utf16 {
-		c.Explanation = GetUnicodeStr(d[:idx])
-	} else {
-		c.Explanation = GetStr(d[:idx])
-	}
-	d = d[idx+len(term):]
+	c.Encoding = GetEncoding(d[0])
+	d = d[1:]
 
+	c.Owner, d = GetTermStr(c.Encoding, d)
+	c.Explanation, d = GetTermStr(c.Encoding, d)
 	c.Block = d
 
 	return c
 }
+
+// Marshal will serialise the frame content for writing
+func (c *CRM) Marshal() []byte {
+	b := []byte{c.Encoding}
+	b = append(b, PutTermStr(c.Owner, c.Encoding)...)
+	b = append(b, PutTermStr(c.Explanation, c.Encoding)...)
+
+	return append(b, c.Block...)
+}
@@ -51,3 +51,7 @@ func TestCrmParseUtf16(t *testing.T) {
 		t.Errorf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func FuzzCrmProcess(f *testing.F) {
+	fuzzFrame(f, "CRM", "\x00owner\x00explain\x00block")
+}
@@ -0,0 +1,101 @@
+package frames
+
+import "fmt"
+
+const (
+	ctocOrderedBit  = 0   // flag bit for child elements being in order
+	ctocTopLevelBit = 1   // flag bit for the root of the table of contents
+	ctocMaxChildren = 255 // the entry count is held in a single byte
+)
+
+// CTOC is a table of contents frame from the chapter addendum, listing the element
+// identifiers of chapters and of further tables of contents. A single table is
+// marked as the top level, being the root of the tree.
+type CTOC struct {
+	Frame
+
+	ElementID string   `json:"element_id" yaml:"element_id"`
+	TopLevel  bool     `json:"top_level" yaml:"top_level"`
+	Ordered   bool     `json:"ordered"`
+	Children  []string `json:"children"`
+	Frames    []IFrame `json:"frames"`
+}
+
+// DisplayContent will comprehensively display known information
+func (c *CTOC) DisplayContent() string {
+	str := fmt.Sprintf("Table of contents [%s] (top level: %v, ordered: %v)\n", c.ElementID, c.TopLevel, c.Ordered)
+	for _, v := range c.Children {
+		str = fmt.Sprintf("%s\tEntry: %s\n", str, v)
+	}
+	for _, v := range c.Frames {
+		str = fmt.Sprintf("%s\t%s", str, v.DisplayContent())
+	}
+
+	return str
+}
+
+// ProcessData will handle the acquisition of all data
+func (c *CTOC) ProcessData(s int, d []byte) IFrame {
+	c.Size = s
+	c.Data = d
+	c.Children = []string{}
+	c.Frames = []IFrame{}
+
+	c.ElementID, d = GetTermStr(EncodingLatin1, d)
+	if len(d) < 2 {
+		c.fail(ErrTruncated, "flags and entry count are missing")
+		return c
+	}
+
+	c.Ordered = GetBoolBit(d[0], ctocOrderedBit)
+	c.TopLevel = GetBoolBit(d[0], ctocTopLevelBit)
+	count := int(d[1])
+	d = d[2:]
+
+	for i := 0; i < count; i++ {
+		if len(d) < 1 {
+			c.fail(ErrTruncated, "found [%d] of [%d] entries", i, count)
+			return c
+		}
+
+		var id string
+		id, d = GetTermStr(EncodingLatin1, d)
+		c.Children = append(c.Children, id)
+	}
+
+	var err error
+	if c.Frames, err = readFrames(c.Version, d); err != nil {
+		c.fail(ErrTruncated, "embedded frames, %s", err)
+	}
+
+	return c
+}
+
+// Marshal will serialise the frame content for writing. Only as many entries as the
+// count can hold are written, with any beyond those left out.
+func (c *CTOC) Marshal() []byte {
+	flags := PutBool(0, ctocOrderedBit, c.Ordered)
+	flags = PutBool(flags, ctocTopLevelBit, c.TopLevel)
+
+	children := c.Children
+	if len(children) > ctocMaxChildren {
+		children = children[:ctocMaxChildren]
+	}
+
+	b := append(PutTermStr(c.ElementID, EncodingLatin1), flags, byte(len(children)))
+	for _, v := range children {
+		b = append(b, PutTermStr(v, EncodingLatin1)...)
+	}
+
+	return append(b, putFrames(c.Version, c.Frames)...)
+}
+
+// GetFrame provides the first embedded frame with the name, or nil when none exist
+func (c *CTOC) GetFrame(n string) IFrame {
+	return findFrame(c.Frames, n)
+}
+
+// Title provides the table of contents title from the embedded TIT2 frame
+func (c *CTOC) Title() string {
+	return findText(c.Frames, "TIT2")
+}
@@ -0,0 +1,69 @@
+package frames
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestCtocProcess(t *testing.T) {
+	b := []byte("toc\x00\x03\x02chp0\x00chp1\x00TIT2\x00\x00\x00\x09\x00\x00\x00Contents")
+	x := NewFrame("CTOC", "", Version4).(*CTOC)
+	if _, err := Process(x, len(b), b); err != nil {
+		t.Fatalf("Got [%s], Expected no error", err)
+	}
+
+	if x.ElementID != "toc" || !x.TopLevel || !x.Ordered {
+		t.Fatalf("Got [%s] [%v] [%v], Expected [toc] [true] [true]", x.ElementID, x.TopLevel, x.Ordered)
+	}
+	if len(x.Children) != 2 || x.Children[0] != "chp0" || x.Children[1] != "chp1" {
+		t.Fatalf("Got [%v], Expected [chp0 chp1]", x.Children)
+	}
+
+	expected := "Contents"
+	found := x.Title()
+	if found != expected {
+		t.Fatalf("Got [%s], Expected [%s]", found, expected)
+	}
+
+	expected = "Table of contents [toc] (top level: true, ordered: true)\n\tEntry: chp0\n\tEntry: chp1\n"
+	found = x.DisplayContent()[:len(expected)]
+	if found != expected {
+		t.Fatalf("Got [%s], Expected [%s]", found, expected)
+	}
+
+	if !bytes.Equal(x.Marshal(), b) {
+		t.Fatalf("Got [%#v], Expected [%#v]", x.Marshal(), b)
+	}
+}
+
+func TestCtocTruncated(t *testing.T) {
+	for _, c := range []string{"toc\x00\x03", "toc\x00\x03\x02chp0\x00"} {
+		x := NewFrame("CTOC", "", Version3)
+		if _, err := Process(x, len(c), []byte(c)); !errors.Is(err, ErrTruncated) {
+			t.Fatalf("Got [%v], Expected [%v]", err, ErrTruncated)
+		}
+	}
+}
+
+func TestCtocMaxChildren(t *testing.T) {
+	x := NewFrame("CTOC", "", Version4).(*CTOC)
+	x.ElementID = "toc"
+	for i := 0; i < 300; i++ {
+		x.Children = append(x.Children, fmt.Sprintf("chp%d", i))
+	}
+
+	y := NewFrame("CTOC", "", Version4).(*CTOC)
+	b := x.Marshal()
+	if _, err := Process(y, len(b), b); err != nil {
+		t.Fatalf("Got [%s], Expected no error", err)
+	}
+	if len(y.Children) != 255 || y.Children[254] != "chp254" {
+		t.Fatalf("Got [%d] entries, Expected [255]", len(y.Children))
+	}
+}
+
+func FuzzCtocProcess(f *testing.F) {
+	fuzzFrame(f, "CTOC", "toc\x00\x03\x02chp0\x00chp1\x00TIT2\x00\x00\x00\x09\x00\x00\x00Contents")
+}
@@ -25,11 +25,22 @@ func (e *ENCR) ProcessData(s int, d []byte) IFrame {
 	e.Data = d
 
 	idx := bytes.IndexByte(d, '\x00')
-	e.Owner = GetStr(d[:idx])
-	e.Method = d[idx]
-	if len(d) > idx {
-		e.EncryptionData = d[idx+1:]
+	if idx < 0 || len(d) < idx+2 {
+		e.fail(ErrTruncated, "method symbol is missing")
+		return e
 	}
 
+	e.Owner = GetLatin1(d[:idx])
+	e.Method = d[idx+1]
+	e.EncryptionData = d[idx+2:]
+
 	return e
 }
+
+// Marshal will serialise the frame content for writing
+func (e *ENCR) Marshal() []byte {
+	b := PutTermStr(e.Owner, EncodingLatin1)
+	b = append(b, e.Method)
+
+	return append(b, e.EncryptionData...)
+}
@@ -20,8 +20,12 @@ func TestEncrBasicOutput(t *testing.T) {
 	b := []byte("Bob\x00\x81\x01\x02\x03\x02")
 	x.ProcessData(len(b), b)
 
-	expected := "Owner: Bob\nMethod: 0"
+	expected := "Owner: Bob\nMethod: 129"
 	if x.DisplayContent() != expected {
 		t.Errorf("Invalid DisplayContent() for ENCR, expected '%s' got '%#v'", expected, x.DisplayContent())
 	}
 }
+
+func FuzzEncrProcess(f *testing.F) {
+	fuzzFrame(f, "ENCR", "owner\x00\x80data")
+}
@@ -1,9 +1,6 @@
 package frames
 
-import (
-	"bytes"
-	"fmt"
-)
+import "fmt"
 
 // EQU2 provides equalisation details for the file
 type EQU2 struct {
@@ -35,6 +32,11 @@ func (e *EQU2) ProcessData(s int, d []byte) IFrame {
 	e.Data = d
 	e.Points = []*point{}
 
+	if len(d) < 1 {
+		e.fail(ErrTruncated, "has no content")
+		return e
+	}
+
 	erp := GetSize([]byte{d[0]}, 0)
 	e.Interpolation = "Linear"
 	if erp == 0 {
@@ -42,9 +44,7 @@ func (e *EQU2) ProcessData(s int, d []byte) IFrame {
 	}
 	d = d[1:]
 
-	idx := bytes.IndexByte(d, '\x00')
-	e.Identification = GetStr(d[:idx])
-	d = d[idx+1:]
+	e.Identification, d = GetTermStr(EncodingLatin1, d)
 
 	for len(d) >= 4 {
 		p := &point{}
@@ -62,3 +62,19 @@ func (e *EQU2) ProcessData(s int, d []byte) IFrame {
 
 	return e
 }
+
+// Marshal will serialise the frame content for writing
+func (e *EQU2) Marshal() []byte {
+	b := []byte{'\x01'}
+	if e.Interpolation == "Band" {
+		b[0] = '\x00'
+	}
+	b = append(b, PutTermStr(e.Identification, EncodingLatin1)...)
+
+	for _, p := range e.Points {
+		b = append(b, PutSize(int(p.Frequency*2), 2, 8)...)
+		b = append(b, PutSize(int(p.Volume*512), 2, 8)...)
+	}
+
+	return b
+}
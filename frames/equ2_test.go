@@ -52,3 +52,7 @@ func TestEqu2Fail(t *testing.T) {
 		t.Errorf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func FuzzEqu2Process(f *testing.F) {
+	fuzzFrame(f, "EQU2", "\x01id\x00\x00\x10\x02\x00")
+}
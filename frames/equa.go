@@ -36,6 +36,11 @@ func (e *EQUA) GetName() string {
 func (e *EQUA) ProcessData(s int, d []byte) IFrame {
 	e.Size = s
 
+	if len(d) < 1 {
+		e.fail(ErrTruncated, "has no content")
+		return e
+	}
+
 	e.Adjustment = GetDirectInt(d[0])
 	d = d[1:]
 	e.Data = d
@@ -47,7 +52,7 @@ func (e *EQUA) ProcessData(s int, d []byte) IFrame {
 		e.Steps = append(e.Steps, &step{
 			Increment:  GetBoolBit(d[0], 8),
 			Frequency:  GetBitInt(d[0], false, uint(7)) + GetBitInt(d[1], false, uint(8)),
-			Adjustment: GetInt(d[2:int(extraBytes)]),
+			Adjustment: GetInt(d[2:chunked]),
 		})
 
 		d = d[chunked:]
@@ -55,3 +60,8 @@ func (e *EQUA) ProcessData(s int, d []byte) IFrame {
 
 	return e
 }
+
+// Marshal will serialise the frame content for writing
+func (e *EQUA) Marshal() []byte {
+	return append([]byte{byte(e.Adjustment)}, e.Data...)
+}
@@ -27,3 +27,7 @@ func TestEquaBasicOutput(t *testing.T) {
 		t.Fatal("DisplayContent() incorrect for EQUA")
 	}
 }
+
+func FuzzEquaProcess(f *testing.F) {
+	fuzzFrame(f, "EQUA", "\x10\x80\x10\x00 ")
+}
@@ -0,0 +1,36 @@
+package frames
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrTruncated is given when content ends before everything it declares
+	ErrTruncated = errors.New("content is truncated")
+	// ErrInvalid is given when content is present but can't be made sense of
+	ErrInvalid = errors.New("content is invalid")
+	// ErrTooLarge is given when content exceeds the size allowed for it
+	ErrTooLarge = errors.New("exceeds the maximum size")
+)
+
+// Err provides the problem found while processing the frame content, if any
+func (f *Frame) Err() error {
+	return f.err
+}
+
+// fail records a problem with the frame content, keeping only the first found
+func (f *Frame) fail(err error, format string, a ...interface{}) {
+	if f.err == nil {
+		f.err = fmt.Errorf("%w: %s %s", err, f.Name, fmt.Sprintf(format, a...))
+	}
+}
+
+// Process is the error returning variant of ProcessData, handing the content to the
+// frame and providing any problem the frame had in processing it
+func Process(fr IFrame, s int, d []byte) (IFrame, error) {
+	base := fr.GetBase()
+	base.err = nil
+
+	return fr.ProcessData(s, d), base.err
+}
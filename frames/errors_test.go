@@ -0,0 +1,63 @@
+package frames
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestProcessErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		data string
+		err  error
+	}{
+		{"SEEK", "\x00\x01", ErrTruncated},
+		{"RVRB", "\x00\x01\x02", ErrInvalid},
+		{"AENC", "\x00\x01\x02\x03\x04", ErrInvalid},
+		{"AENC", "owner\x00\x01", ErrTruncated},
+		{"RVAD", "\x03\x10\x00\x01", ErrTruncated},
+		{"RVAD", "\x03\x00\x00\x01", ErrInvalid},
+		{"ASPI", "\x00\x00\x00\x01\x00\x00\x00\x01\x00\x02\x00\x01\x02", ErrInvalid},
+		{"APIC", "", ErrTruncated},
+		{"APIC", "\x00image/png", ErrTruncated},
+		{"COMR", "\x00USD1\x002020", ErrTruncated},
+		{"ENCR", "owner", ErrTruncated},
+		{"GRID", "owner\x00", ErrTruncated},
+		{"LINK", "TI", ErrTruncated},
+		{"OWNE", "\x00USD1\x00202", ErrTruncated},
+		{"POPM", "bob@example.com", ErrTruncated},
+		{"RBUF", "\x00\x10", ErrTruncated},
+		{"RVA2", "id\x00\x01\x02", ErrTruncated},
+		{"SYLT", "\x00eng\x02\x01desc\x00line\x00\x00\x00\x01", ErrTruncated},
+		{"USLT", "\x00en", ErrTruncated},
+	}
+
+	for _, c := range cases {
+		f := NewFrame(c.name, "", Version4)
+		out, err := Process(f, len(c.data), []byte(c.data))
+		if !errors.Is(err, c.err) {
+			t.Fatalf("Got [%v], Expected [%v] for [%s]", err, c.err, c.name)
+		}
+		if out != f || f.Err() != err {
+			t.Fatalf("Expected the frame and its error to be given for [%s]", c.name)
+		}
+	}
+
+	f := NewFrame("SEEK", "", Version4)
+	if _, err := Process(f, 4, []byte("\x00\x00\x00\x01")); err != nil {
+		t.Fatalf("Got [%v], Expected no error", err)
+	}
+	if NewFrame("XXXX", "", Version4) != nil {
+		t.Fatalf("Expected no frame for an unknown name")
+	}
+}
+
+func TestRvadOptionalChannels(t *testing.T) {
+	r := NewFrame("RVAD", "", Version3).(*RVAD)
+	if _, err := Process(r, 6, []byte("\x03\x08\x02\x04\x06\x08")); err != nil {
+		t.Fatalf("Got [%v], Expected front channels alone to be valid", err)
+	}
+	if r.PeakLeft != float64(8)/512 || r.RelativeBass != 0 {
+		t.Fatalf("Got [%v] and [%v], Expected [%v] and [0]", r.PeakLeft, r.RelativeBass, float64(8)/512)
+	}
+}
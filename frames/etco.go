@@ -1,31 +1,181 @@
 package frames
 
-import "fmt"
+import (
+	"fmt"
+	"sort"
+)
 
 // ETCO provides timing codes for events in the file
 type ETCO struct {
 	Frame
 
-	Format byte   `json:"encoding"`
-	Codes  []byte `json:"codes"`
+	Format TimestampFormat `json:"format"`
+	Events []*TimingEvent  `json:"events"`
+}
+
+// TimestampFormat is the unit that time stamps are given in
+type TimestampFormat byte
+
+// Time stamps are given as either a count of MPEG frames or milliseconds
+const (
+	TimestampMPEG         TimestampFormat = 1
+	TimestampMilliseconds TimestampFormat = 2
+)
+
+// String provides the short name of the unit
+func (t TimestampFormat) String() string {
+	switch t {
+	case TimestampMPEG:
+		return "mpeg"
+	case TimestampMilliseconds:
+		return "ms"
+	}
+
+	return "unknown"
+}
+
+// TimingEvent is a single event within the audio, beginning at the time stamp
+type TimingEvent struct {
+	Code      EventCode `json:"code"`
+	Label     string    `json:"label"`
+	Timestamp int       `json:"timestamp"`
+}
+
+// EventCode is the type of a timing event
+type EventCode byte
+
+// Event codes defined by the spec, with $E0 to $EF being left for events that aren't
+// predefined
+const (
+	EventPadding EventCode = iota
+	EventInitialSilenceEnd
+	EventIntroStart
+	EventMainPartStart
+	EventOutroStart
+	EventOutroEnd
+	EventVerseStart
+	EventRefrainStart
+	EventInterludeStart
+	EventThemeStart
+	EventVariationStart
+	EventKeyChange
+	EventTimeChange
+	EventUnwantedNoise
+	EventSustainedNoise
+	EventSustainedNoiseEnd
+	EventIntroEnd
+	EventMainPartEnd
+	EventVerseEnd
+	EventRefrainEnd
+	EventThemeEnd
+	EventProfanity
+	EventProfanityEnd
+
+	EventSynchStart   EventCode = 0xe0
+	EventSynchEnd     EventCode = 0xef
+	EventAudioEnd     EventCode = 0xfd
+	EventAudioFileEnd EventCode = 0xfe
+	EventMore         EventCode = 0xff
+)
+
+var eventList = map[EventCode]string{
+	EventPadding:           "padding",
+	EventInitialSilenceEnd: "end of initial silence",
+	EventIntroStart:        "intro start",
+	EventMainPartStart:     "main part start",
+	EventOutroStart:        "outro start",
+	EventOutroEnd:          "outro end",
+	EventVerseStart:        "verse start",
+	EventRefrainStart:      "refrain start",
+	EventInterludeStart:    "interlude start",
+	EventThemeStart:        "theme start",
+	EventVariationStart:    "variation start",
+	EventKeyChange:         "key change",
+	EventTimeChange:        "time change",
+	EventUnwantedNoise:     "momentary unwanted noise",
+	EventSustainedNoise:    "sustained noise",
+	EventSustainedNoiseEnd: "sustained noise end",
+	EventIntroEnd:          "intro end",
+	EventMainPartEnd:       "main part end",
+	EventVerseEnd:          "verse end",
+	EventRefrainEnd:        "refrain end",
+	EventThemeEnd:          "theme end",
+	EventProfanity:         "profanity",
+	EventProfanityEnd:      "profanity end",
+	EventAudioEnd:          "audio end",
+	EventAudioFileEnd:      "audio file ends",
+	EventMore:              "one more byte of events follows",
+}
+
+// String provides the name of the event
+func (e EventCode) String() string {
+	if l, ok := eventList[e]; ok {
+		return l
+	}
+	if e >= EventSynchStart && e <= EventSynchEnd {
+		return fmt.Sprintf("not predefined synch %X", byte(e-EventSynchStart))
+	}
+
+	return "reserved"
 }
 
 // DisplayContent will comprehensively display known information
 func (e *ETCO) DisplayContent() string {
-	return fmt.Sprintf("Format: %d\nCode Count: %d", GetDirectInt(e.Format), len(e.Codes)/5)
+	out := fmt.Sprintf("Event timing (%s)\n", e.Format)
+	for _, v := range e.Events {
+		out = fmt.Sprintf("%s\t%s [%d]\n", out, v.Code, v.Timestamp)
+	}
+
+	return out
 }
 
 // ProcessData will handle the acquisition of all data
 func (e *ETCO) ProcessData(s int, d []byte) IFrame {
 	e.Size = s
 	e.Data = d
+	e.Events = []*TimingEvent{}
+
+	if len(d) < 1 {
+		e.fail(ErrTruncated, "has no content")
+		return e
+	}
 
-	// there is a format to this, it's just not enhanced here
-	// format of 0 for mpeg frames, 1 for milliseconds
-	e.Format = d[0]
+	e.Format = TimestampFormat(d[0])
+	d = d[1:]
 
 	// groupings of 5, first byte is the type, 4 bytes for the time stamp
-	e.Codes = d[1:]
+	for len(d) >= 5 {
+		e.Events = append(e.Events, &TimingEvent{
+			Code:      EventCode(d[0]),
+			Label:     EventCode(d[0]).String(),
+			Timestamp: GetSize(d[1:5], 8),
+		})
+		d = d[5:]
+	}
 
 	return e
 }
+
+// SetEvents will replace the events, ordering them by time stamp as the spec asks
+// and labelling each from its code
+func (e *ETCO) SetEvents(v ...*TimingEvent) {
+	e.Events = append([]*TimingEvent{}, v...)
+	sort.SliceStable(e.Events, func(i, j int) bool {
+		return e.Events[i].Timestamp < e.Events[j].Timestamp
+	})
+
+	for _, x := range e.Events {
+		x.Label = x.Code.String()
+	}
+}
+
+// Marshal will serialise the frame content for writing
+func (e *ETCO) Marshal() []byte {
+	b := []byte{byte(e.Format)}
+	for _, v := range e.Events {
+		b = append(b, byte(v.Code))
+		b = append(b, PutSize(v.Timestamp, 4, 8)...)
+	}
+
+	return b
+}
@@ -14,7 +14,81 @@ func TestEtcoBasicOutput(t *testing.T) {
 
 	b := []byte("\x01\x02\x01\x00\x03\xa4")
 	e.ProcessData(len(b), b)
-	if e.DisplayContent() != "Format: 1\nCode Count: 1" {
-		t.Error("Invalid DisplayContent() for ETCO")
+	expected := "Event timing (mpeg)\n\tintro start [16778148]\n"
+	if found := e.DisplayContent(); found != expected {
+		t.Errorf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func TestEtcoProcess(t *testing.T) {
+	e := NewFrame("ETCO", "", Version4).(*ETCO)
+	b := []byte("\x02\x01\x00\x00\x03\xe8\x03\x00\x00\x07\xd0\x0b\x00\x01\x86\xa0\xe2\x00\x01\xd4\xc0\x77\x00\x02\x00\x00\xfd\x00\x02")
+
+	e.ProcessData(len(b), b)
+	if e.Format != TimestampMilliseconds {
+		t.Fatalf("Got [%s], Expected [ms]", e.Format)
+	}
+
+	expected := []struct {
+		code  EventCode
+		label string
+		time  int
+	}{
+		{EventInitialSilenceEnd, "end of initial silence", 1000},
+		{EventMainPartStart, "main part start", 2000},
+		{EventKeyChange, "key change", 100000},
+		{0xe2, "not predefined synch 2", 120000},
+		{0x77, "reserved", 131072},
+	}
+	if len(e.Events) != len(expected) {
+		t.Fatalf("Got [%d], Expected [%d] events", len(e.Events), len(expected))
+	}
+	for i, v := range expected {
+		x := e.Events[i]
+		if x.Code != v.code || x.Label != v.label || x.Timestamp != v.time {
+			t.Fatalf("Got [%#x, %s, %d], Expected [%#x, %s, %d]", byte(x.Code), x.Label, x.Timestamp, byte(v.code), v.label, v.time)
+		}
+	}
+
+	// the trailing partial event is left out
+	if m := string(e.Marshal()); m != string(b[:len(b)-3]) {
+		t.Fatalf("Got [%#v], Expected [%#v]", m, string(b[:len(b)-3]))
+	}
+}
+
+func TestEtcoSetEvents(t *testing.T) {
+	e := NewFrame("ETCO", "", Version4).(*ETCO)
+	e.Format = TimestampMPEG
+	e.SetEvents(
+		&TimingEvent{Code: EventAudioEnd, Timestamp: 9000},
+		&TimingEvent{Code: EventIntroStart, Timestamp: 10},
+		&TimingEvent{Code: EventProfanity, Timestamp: 500},
+	)
+
+	if e.Events[0].Label != "intro start" || e.Events[2].Label != "audio end" {
+		t.Fatalf("Got [%s, %s], Expected events in time order", e.Events[0].Label, e.Events[2].Label)
+	}
+
+	expected := "\x01\x02\x00\x00\x00\x0a\x15\x00\x00\x01\xf4\xfd\x00\x00\x23\x28"
+	if m := string(e.Marshal()); m != expected {
+		t.Fatalf("Got [%#v], Expected [%#v]", m, expected)
+	}
+
+	x := NewFrame("ETCO", "", Version4).(*ETCO)
+	x.ProcessData(len(expected), []byte(expected))
+	if x.Format != TimestampMPEG || len(x.Events) != 3 || x.Events[1].Code != EventProfanity {
+		t.Fatalf("Got [%s], Expected the events to be read back", x.DisplayContent())
+	}
+}
+
+func TestTimestampFormat(t *testing.T) {
+	for f, s := range map[TimestampFormat]string{TimestampMPEG: "mpeg", TimestampMilliseconds: "ms", 0: "unknown"} {
+		if f.String() != s {
+			t.Fatalf("Got [%s], Expected [%s]", f, s)
+		}
+	}
+}
+
+func FuzzEtcoProcess(f *testing.F) {
+	fuzzFrame(f, "ETCO", "\x02\x01\x00\x00\x00\x10")
+}
@@ -0,0 +1,235 @@
+package frames
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	v23TagPreserveBit  = 15 // bits are counted across both flag bytes
+	v23FilePreserveBit = 14
+	v23ReadOnlyBit     = 13
+	v23CompressionBit  = 7
+	v23EncryptionBit   = 6
+	v23GroupingBit     = 5
+
+	v24TagPreserveBit         = 14
+	v24FilePreserveBit        = 13
+	v24ReadOnlyBit            = 12
+	v24GroupingBit            = 6
+	v24CompressionBit         = 3
+	v24EncryptionBit          = 2
+	v24UnsynchronisationBit   = 1
+	v24DataLengthIndicatorBit = 0
+
+	lengthDataIndicator = 4 // bytes used for a data length
+
+	// MaxInflateSize is the most compressed content is inflated to when no limit is
+	// given, guarding against content that inflates far beyond its frame
+	MaxInflateSize = 64 << 20
+)
+
+// ProcessFlags will decode the two frame header flag bytes using the layout of the
+// given version. Version 2.2 frames carry no flags and are left untouched.
+func (f *Frame) ProcessFlags(version int, flags []byte) {
+	if version < Version3 || len(flags) < 2 {
+		return
+	}
+
+	f.Flags = GetSize(flags[:2], 8)
+	bit := func(i uint) bool {
+		return f.Flags&(1<<i) != 0
+	}
+
+	if version == Version3 {
+		f.TagPreserve = bit(v23TagPreserveBit)
+		f.FilePreserve = bit(v23FilePreserveBit)
+		f.ReadOnly = bit(v23ReadOnlyBit)
+		f.Compression = bit(v23CompressionBit)
+		f.Encryption = bit(v23EncryptionBit)
+		f.Grouping = bit(v23GroupingBit)
+		return
+	}
+
+	f.TagPreserve = bit(v24TagPreserveBit)
+	f.FilePreserve = bit(v24FilePreserveBit)
+	f.ReadOnly = bit(v24ReadOnlyBit)
+	f.Grouping = bit(v24GroupingBit)
+	f.Compression = bit(v24CompressionBit)
+	f.Encryption = bit(v24EncryptionBit)
+	f.Unsynchronisation = bit(v24UnsynchronisationBit)
+	f.DataLengthIndicator = bit(v24DataLengthIndicatorBit)
+}
+
+// ProcessHeaderData will reverse any v2.4 frame unsynchronisation and consume the
+// additional header bytes that the flags call for, providing the remaining content.
+// The additional bytes are ordered differently between v2.3 and v2.4. Compressed
+// content is inflated, unless it is also encrypted, with content that can't be
+// inflated given back as it was found alongside the problem. Inflated content is
+// held to limit bytes, or MaxInflateSize when limit isn't above zero.
+func (f *Frame) ProcessHeaderData(version int, d []byte, limit int) ([]byte, error) {
+	if version == Version4 && f.Unsynchronisation {
+		d = Desync(d)
+	}
+
+	take := func(l int) []byte {
+		if len(d) < l {
+			l = len(d)
+		}
+
+		b := d[:l]
+		d = d[l:]
+		return b
+	}
+
+	switch version {
+	case Version3:
+		if f.Compression {
+			f.DataLength = GetSize(take(lengthDataIndicator), 8)
+		}
+		if f.Encryption {
+			f.EncryptionMethod = GetDirectByte(take(1))
+		}
+		if f.Grouping {
+			f.GroupID = GetDirectByte(take(1))
+		}
+	case Version4:
+		if f.Grouping {
+			f.GroupID = GetDirectByte(take(1))
+		}
+		if f.Encryption {
+			f.EncryptionMethod = GetDirectByte(take(1))
+		}
+		if f.DataLengthIndicator {
+			f.DataLength = GetSize(take(lengthDataIndicator), 7)
+		}
+	}
+
+	if f.Compression && !f.Encryption {
+		if limit < 1 {
+			limit = MaxInflateSize
+		}
+
+		b, err := inflate(d, f.DataLength, limit)
+		if errors.Is(err, ErrTooLarge) {
+			return d, fmt.Errorf("%w: %s compressed content inflates past [%d] bytes", err, f.Name, limit)
+		}
+		if err != nil {
+			return d, fmt.Errorf("%w: %s compressed content, %s", ErrInvalid, f.Name, err)
+		}
+		d = b
+	}
+
+	return d, nil
+}
+
+// PutFlags will provide the frame header flags for the version alongside the content
+// prefixed with the additional header bytes those flags call for. Content is
+// deflated when compress is set. The content given is never encrypted, so the
+// encryption flag is left out; encrypted frames are kept as unknown frames instead,
+// which are written with the flags they were found with.
+func (f *Frame) PutFlags(version int, unsync, compress bool, d []byte) (int, []byte) {
+	if version < Version3 {
+		return 0, d
+	}
+
+	flags := 0
+	set := func(i uint, v bool) {
+		if v {
+			flags |= 1 << i
+		}
+	}
+
+	head := []byte{}
+	size := len(d)
+	if compress {
+		d = deflate(d)
+	}
+
+	if version == Version3 {
+		set(v23TagPreserveBit, f.TagPreserve)
+		set(v23FilePreserveBit, f.FilePreserve)
+		set(v23ReadOnlyBit, f.ReadOnly)
+		set(v23CompressionBit, compress)
+		set(v23GroupingBit, f.Grouping)
+		if compress {
+			head = append(head, PutSize(size, lengthDataIndicator, 8)...)
+		}
+		if f.Grouping {
+			head = append(head, f.GroupID)
+		}
+
+		return flags, append(head, d...)
+	}
+
+	// compression in v2.4 relies upon the data length indicator
+	dli := f.DataLengthIndicator || compress
+	set(v24TagPreserveBit, f.TagPreserve)
+	set(v24FilePreserveBit, f.FilePreserve)
+	set(v24ReadOnlyBit, f.ReadOnly)
+	set(v24GroupingBit, f.Grouping)
+	set(v24CompressionBit, compress)
+	set(v24UnsynchronisationBit, unsync)
+	set(v24DataLengthIndicatorBit, dli)
+
+	if f.Grouping {
+		head = append(head, f.GroupID)
+	}
+	if dli {
+		head = append(head, PutSize(size, lengthDataIndicator, 7)...)
+	}
+
+	d = append(head, d...)
+	if unsync {
+		d = Unsync(d)
+	}
+
+	return flags, d
+}
+
+// GetDirectByte will provide the first byte, or zero when there are none
+func GetDirectByte(b []byte) byte {
+	if len(b) < 1 {
+		return 0
+	}
+
+	return b[0]
+}
+
+// inflate will decompress zlib content, stopping at the expected size when known.
+// Content inflating past the limit is refused, whatever size it claims.
+func inflate(d []byte, l, limit int) ([]byte, error) {
+	r, err := zlib.NewReader(bytes.NewReader(d))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	n := int64(limit) + 1
+	if l > 0 && l <= limit {
+		n = int64(l)
+	}
+
+	var b bytes.Buffer
+	if _, err = io.Copy(&b, io.LimitReader(r, n)); err != nil {
+		return nil, err
+	}
+	if b.Len() > limit {
+		return nil, ErrTooLarge
+	}
+
+	return b.Bytes(), nil
+}
+
+// deflate will compress content with zlib
+func deflate(d []byte) []byte {
+	var b bytes.Buffer
+	w := zlib.NewWriter(&b)
+	_, _ = w.Write(d)
+	_ = w.Close()
+
+	return b.Bytes()
+}
@@ -0,0 +1,127 @@
+package frames
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestProcessFlags(t *testing.T) {
+	f := &Frame{}
+	f.ProcessFlags(Version3, []byte{'\xe0', '\xe0'})
+	if !f.TagPreserve || !f.FilePreserve || !f.ReadOnly || !f.Compression || !f.Encryption || !f.Grouping {
+		t.Fatalf("Expected all v2.3 flags to be set, Got [%#v]", f)
+	}
+	if f.Unsynchronisation || f.DataLengthIndicator {
+		t.Fatalf("Expected v2.4 only flags to be unset for v2.3")
+	}
+
+	f = &Frame{}
+	f.ProcessFlags(Version4, []byte{'\x70', '\x4f'})
+	if !f.TagPreserve || !f.FilePreserve || !f.ReadOnly || !f.Compression || !f.Encryption || !f.Grouping {
+		t.Fatalf("Expected all v2.4 flags to be set, Got [%#v]", f)
+	}
+	if !f.Unsynchronisation || !f.DataLengthIndicator {
+		t.Fatalf("Expected unsynchronisation and data length indicator for v2.4")
+	}
+
+	f = &Frame{}
+	f.ProcessFlags(Version2, []byte{'\xff', '\xff'})
+	if f.Flags != 0 || f.TagPreserve {
+		t.Fatalf("Expected no flags for v2.2, Got [%d]", f.Flags)
+	}
+}
+
+func TestProcessHeaderData(t *testing.T) {
+	f := &Frame{Compression: true, Encryption: true, Grouping: true}
+	d, _ := f.ProcessHeaderData(Version3, []byte("\x00\x00\x01\x00\x81\x05data"), 0)
+	if string(d) != "data" || f.DataLength != 256 || f.EncryptionMethod != 0x81 || f.GroupID != 5 {
+		t.Fatalf("Got [%s] with [%#v]", d, f)
+	}
+
+	f = &Frame{Grouping: true, Encryption: true, DataLengthIndicator: true, Unsynchronisation: true}
+	d, _ = f.ProcessHeaderData(Version4, []byte("\x05\x81\x00\x00\x02\x00\xff\x00\xe0"), 0)
+	if !bytes.Equal(d, []byte("\xff\xe0")) || f.DataLength != 256 || f.EncryptionMethod != 0x81 || f.GroupID != 5 {
+		t.Fatalf("Got [%#v] with [%#v]", d, f)
+	}
+
+	f = &Frame{Grouping: true, DataLengthIndicator: true}
+	d, _ = f.ProcessHeaderData(Version4, []byte("\x05"), 0)
+	if len(d) != 0 || f.GroupID != 5 {
+		t.Fatalf("Expected short data to be consumed safely, Got [%#v]", d)
+	}
+}
+
+func TestPutFlags(t *testing.T) {
+	f := &Frame{ReadOnly: true, Grouping: true, GroupID: 3, Compression: true}
+	flags, d := f.PutFlags(Version3, false, false, []byte("data"))
+	if flags != 0x2020 || string(d) != "\x03data" {
+		t.Fatalf("Got [%#x] [%#v], Expected [0x2020] [\"\\x03data\"]", flags, d)
+	}
+
+	f = &Frame{Grouping: true, GroupID: 3, DataLengthIndicator: true}
+	flags, d = f.PutFlags(Version4, true, false, []byte("\xff\xe0"))
+	if flags != 0x43 || !bytes.Equal(d, []byte("\x03\x00\x00\x00\x02\xff\x00\xe0")) {
+		t.Fatalf("Got [%#x] [%#v]", flags, d)
+	}
+
+	g := &Frame{}
+	g.ProcessFlags(Version4, PutSize(flags, 2, 8))
+	if r, _ := g.ProcessHeaderData(Version4, d, 0); !bytes.Equal(r, []byte("\xff\xe0")) || g.GroupID != 3 {
+		t.Fatalf("Got [%#v], Expected the original content", r)
+	}
+
+	flags, d = f.PutFlags(Version2, false, false, []byte("data"))
+	if flags != 0 || string(d) != "data" {
+		t.Fatalf("Got [%#x] [%s], Expected no flags for v2.2", flags, d)
+	}
+}
+
+func TestCompressedFlags(t *testing.T) {
+	content := bytes.Repeat([]byte("compress me "), 20)
+	for _, v := range []int{Version3, Version4} {
+		f := &Frame{Grouping: true, GroupID: 4}
+		flags, d := f.PutFlags(v, false, true, content)
+		if len(d) >= len(content) {
+			t.Fatalf("Expected compressed content for v2.%d, Got [%d] bytes", v, len(d))
+		}
+
+		g := &Frame{}
+		g.ProcessFlags(v, PutSize(flags, 2, 8))
+		if !g.Compression {
+			t.Fatalf("Expected the compression flag for v2.%d", v)
+		}
+
+		r, _ := g.ProcessHeaderData(v, d, 0)
+		if !bytes.Equal(r, content) || g.DataLength != len(content) || g.GroupID != 4 {
+			t.Fatalf("Got [%s] with length [%d], Expected [%s]", r, g.DataLength, content)
+		}
+	}
+
+	f := &Frame{Name: "TPE1", Compression: true}
+	r, err := f.ProcessHeaderData(Version3, []byte("\x00\x00\x00\x04junk"), 0)
+	if string(r) != "junk" || !errors.Is(err, ErrInvalid) {
+		t.Fatalf("Got [%s] [%v], Expected invalid content to be left as is with [%s]", r, err, ErrInvalid)
+	}
+}
+
+func TestCompressedLimit(t *testing.T) {
+	z := deflate(make([]byte, 1000))
+
+	// a v2.4 frame without a data length indicator claims no size at all
+	f := &Frame{Name: "TPE1", Compression: true}
+	if _, err := f.ProcessHeaderData(Version4, z, 100); !errors.Is(err, ErrTooLarge) {
+		t.Fatalf("Got [%v], Expected [%s]", err, ErrTooLarge)
+	}
+
+	// nor is a claimed size beyond the limit trusted
+	f = &Frame{Name: "TPE1", Compression: true}
+	if _, err := f.ProcessHeaderData(Version3, append(PutSize(1000, 4, 8), z...), 100); !errors.Is(err, ErrTooLarge) {
+		t.Fatalf("Got [%v], Expected [%s]", err, ErrTooLarge)
+	}
+
+	f = &Frame{Name: "TPE1", Compression: true}
+	if r, err := f.ProcessHeaderData(Version4, z, 1000); err != nil || len(r) != 1000 {
+		t.Fatalf("Got [%d] [%v], Expected [1000] bytes", len(r), err)
+	}
+}
@@ -2,6 +2,7 @@
 package frames
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"math"
@@ -20,8 +21,12 @@ type IFrame interface {
 	GetLength() int
 	GetName() string
 
+	GetBase() *Frame
+	Err() error
+
 	Init(n, d string, s int)
 	ProcessData(int, []byte) IFrame
+	Marshal() []byte
 }
 
 // FrameFile provides an interface for overloading of os.File
@@ -43,6 +48,15 @@ const (
 	LengthUnicode = 2
 	// LengthStandard defines the normal byte size for a character
 	LengthStandard = 1
+
+	// EncodingLatin1 denotes ISO-8859-1 text with a single null terminator
+	EncodingLatin1 = 0
+	// EncodingUTF16 denotes UTF-16 text with a BOM and a double null terminator
+	EncodingUTF16 = 1
+	// EncodingUTF16BE denotes big endian UTF-16 text without a BOM, from v2.4
+	EncodingUTF16BE = 2
+	// EncodingUTF8 denotes UTF-8 text with a single null terminator, from v2.4
+	EncodingUTF8 = 3
 )
 
 // Frame defines a base structure shared across all Frame types. This frame
@@ -55,15 +69,25 @@ type Frame struct {
 	Cleaned     string `json:"cleaned"`
 	Size        int    `json:"size"`
 
-	Flags        int  `json:"flags"`
-	TagPreserve  bool `json:"tag_preserve"`
-	FilePreserve bool `json:"file_preserve"`
-	ReadOnly     bool `json:"read_only"`
-	Compression  bool `json:"compression"`
-	Encryption   bool `json:"encryption"`
-	Grouping     bool `json:"grouping"`
-
-	Utf16 bool `json:"utf16"`
+	// TagPreserve and FilePreserve mirror the alter preservation flags, so are set
+	// when the frame should be discarded once the tag or file is altered
+	Flags               int  `json:"flags"`
+	TagPreserve         bool `json:"tag_preserve"`
+	FilePreserve        bool `json:"file_preserve"`
+	ReadOnly            bool `json:"read_only"`
+	Compression         bool `json:"compression"`
+	Encryption          bool `json:"encryption"`
+	Grouping            bool `json:"grouping"`
+	Unsynchronisation   bool `json:"unsynchronisation"`
+	DataLengthIndicator bool `json:"data_length_indicator"`
+
+	GroupID          byte `json:"group_id"`
+	EncryptionMethod byte `json:"encryption_method"`
+	DataLength       int  `json:"data_length"`
+
+	Encoding byte `json:"encoding"`
+
+	err error
 }
 
 // GetStr will convert the byte slice into a String
@@ -86,22 +110,33 @@ func GetStr(b []byte) string {
 	return strings.Trim(str, " \t\n\r\x00")
 }
 
-// GetUnicodeStr will read a unicode byte slice to a string
-func GetUnicodeStr(d []byte) string {
-	byteOrder := []byte{d[0], d[1]}
-	var b binary.ByteOrder
-	d = d[2:]
+// GetLatin1 will decode an ISO-8859-1 byte slice to a string
+func GetLatin1(b []byte) string {
+	r := make([]rune, len(b))
+	for i, v := range b {
+		r[i] = rune(v)
+	}
 
-	resp := ""
+	return strings.Trim(string(r), " \t\n\r\x00")
+}
+
+// GetUnicodeStr will read a unicode byte slice to a string, making use of the BOM
+// when present and falling back to big endian otherwise
+func GetUnicodeStr(d []byte) string {
+	var b binary.ByteOrder = binary.BigEndian
 	if len(d) > 1 {
-		if byteOrder[0] == '\xFF' && byteOrder[1] == '\xFE' {
+		if d[0] == '\xFF' && d[1] == '\xFE' {
 			b = binary.LittleEndian
-		} else if byteOrder[0] == '\xFE' && byteOrder[1] == '\xFF' {
-			b = binary.BigEndian
+			d = d[2:]
+		} else if d[0] == '\xFE' && d[1] == '\xFF' {
+			d = d[2:]
 		}
+	}
 
+	resp := ""
+	if len(d) > 1 {
 		str := make([]uint16, 0, len(d)/2)
-		for i := 0; i < len(d); i += 2 {
+		for i := 0; i+1 < len(d); i += 2 {
 			str = append(str, b.Uint16(d[i:i+2]))
 		}
 
@@ -111,6 +146,99 @@ func GetUnicodeStr(d []byte) string {
 	return resp
 }
 
+// GetEncoding will validate an encoding byte, falling back to ISO-8859-1 for
+// anything unknown
+func GetEncoding(b byte) byte {
+	if b > EncodingUTF8 {
+		return EncodingLatin1
+	}
+
+	return b
+}
+
+// IsUnicode determines if the encoding makes use of two byte characters
+func IsUnicode(enc byte) bool {
+	return enc == EncodingUTF16 || enc == EncodingUTF16BE
+}
+
+// GetTermLength provides the length of a string terminator for the encoding
+func GetTermLength(enc byte) int {
+	if IsUnicode(enc) {
+		return LengthUnicode
+	}
+
+	return LengthStandard
+}
+
+// GetEncStr will decode a byte slice to a string using the text encoding
+func GetEncStr(enc byte, b []byte) string {
+	switch enc {
+	case EncodingUTF16, EncodingUTF16BE:
+		return strings.Trim(GetUnicodeStr(b), " \t\n\r\x00")
+	case EncodingUTF8:
+		return GetStr(b)
+	}
+
+	return GetLatin1(b)
+}
+
+// GetTerm will find the offset of the string terminator for the encoding, aligned
+// to the character size, or -1 when there is no terminator
+func GetTerm(enc byte, b []byte) int {
+	if !IsUnicode(enc) {
+		return bytes.IndexByte(b, '\x00')
+	}
+
+	for i := 0; i+1 < len(b); i += LengthUnicode {
+		if b[i] == '\x00' && b[i+1] == '\x00' {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// GetEncStrs will decode a byte slice of null separated strings in the encoding,
+// skipping any empty values. UTF-16 values without a BOM make use of the order
+// from an earlier value.
+func GetEncStrs(enc byte, b []byte) []string {
+	out := []string{}
+	bom := []byte{}
+
+	for len(b) > 0 {
+		v := b
+		b = []byte{}
+		if idx := GetTerm(enc, v); idx >= 0 {
+			v, b = v[:idx], v[idx+GetTermLength(enc):]
+		}
+
+		if enc == EncodingUTF16 && len(v) > 1 {
+			if (v[0] == '\xFF' && v[1] == '\xFE') || (v[0] == '\xFE' && v[1] == '\xFF') {
+				bom = v[:2]
+			} else {
+				v = append(append([]byte{}, bom...), v...)
+			}
+		}
+
+		if s := GetEncStr(enc, v); len(s) > 0 {
+			out = append(out, s)
+		}
+	}
+
+	return out
+}
+
+// GetTermStr will read a terminated string in the encoding, providing the string
+// and the remaining bytes. Without a terminator all bytes are the string.
+func GetTermStr(enc byte, b []byte) (string, []byte) {
+	idx := GetTerm(enc, b)
+	if idx < 0 {
+		return GetEncStr(enc, b), []byte{}
+	}
+
+	return GetEncStr(enc, b[:idx]), b[idx+GetTermLength(enc):]
+}
+
 // GetInt will make use of GetStr and convert the input to an Integer
 func GetInt(b []byte) int {
 	s, _ := strconv.Atoi(GetStr(b)) // convert internally to string and then to integer
@@ -169,6 +297,149 @@ func GetBytePercent(b []byte, sig uint) int {
 	return int(x)
 }
 
+// PutSize will generate a byte slice of length l for the int value, the inverse of GetSize
+func PutSize(v, l int, sig uint) []byte {
+	b := make([]byte, l)
+	mask := 1<<sig - 1
+	for i := l - 1; i >= 0; i-- {
+		b[i] = byte(v & mask)
+		v >>= sig
+	}
+
+	return b
+}
+
+// IsSynchsafe determines if the bytes hold a synchsafe integer, where the most
+// significant bit of every byte is left clear
+func IsSynchsafe(b []byte) bool {
+	for _, v := range b {
+		if v&0x80 != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// PutStr will convert a string into bytes for the text encoding. UTF-16 with a BOM
+// is written as big endian.
+func PutStr(s string, enc byte) []byte {
+	switch enc {
+	case EncodingUTF16, EncodingUTF16BE:
+		b := []byte{}
+		if enc == EncodingUTF16 {
+			b = append(b, '\xFE', '\xFF')
+		}
+		for _, v := range utf16.Encode([]rune(s)) {
+			b = append(b, byte(v>>8), byte(v))
+		}
+
+		return b
+	case EncodingUTF8:
+		return []byte(s)
+	}
+
+	return PutLatin1(s)
+}
+
+// PutLatin1 will convert a string into ISO-8859-1 bytes, with characters outside of
+// the range replaced by a question mark
+func PutLatin1(s string) []byte {
+	b := make([]byte, 0, len(s))
+	for _, r := range s {
+		if r > '\xff' {
+			r = '?'
+		}
+		b = append(b, byte(r))
+	}
+
+	return b
+}
+
+// PutTermStr will convert a string into bytes followed by the appropriate terminator
+func PutTermStr(s string, enc byte) []byte {
+	return append(PutStr(s, enc), make([]byte, GetTermLength(enc))...)
+}
+
+// Desync will reverse unsynchronisation, removing the null following each 0xFF
+func Desync(b []byte) []byte {
+	out := make([]byte, 0, len(b))
+	for i := 0; i < len(b); i++ {
+		out = append(out, b[i])
+		if b[i] == 0xFF && i+1 < len(b) && b[i+1] == 0x00 {
+			i++
+		}
+	}
+
+	return out
+}
+
+// Unsync will apply unsynchronisation, placing a null after any 0xFF that could
+// be mistaken for an MPEG sync or that ends the data
+func Unsync(b []byte) []byte {
+	out := make([]byte, 0, len(b))
+	for i, v := range b {
+		out = append(out, v)
+		if v == 0xFF && (i+1 == len(b) || b[i+1] == 0x00 || b[i+1] >= 0xE0) {
+			out = append(out, 0x00)
+		}
+	}
+
+	return out
+}
+
+// PutBool will set the bit at the offset within a byte, the inverse of GetBoolBit
+func PutBool(b byte, i uint, v bool) byte {
+	if v {
+		return b | byte(1<<i)
+	}
+
+	return b &^ byte(1<<i)
+}
+
+// PutBytePercent will generate a byte from a percentage, the inverse of GetBytePercent
+func PutBytePercent(p int) byte {
+	v := p * 256 / 100
+	if v > 255 {
+		v = 255
+	}
+
+	return byte(v)
+}
+
+func putFixed(s string, l int) []byte {
+	b := make([]byte, l)
+	copy(b, PutLatin1(s))
+
+	return b
+}
+
+// getCounter reads a play counter, which is at least 32 bits and may be extended
+func getCounter(b []byte) uint64 {
+	var v uint64
+	for _, x := range b {
+		v = v<<8 | uint64(x)
+	}
+
+	return v
+}
+
+// putCounter writes a play counter, extending it past 32 bits only when needed
+func putCounter(v uint64) []byte {
+	l := 4
+	for x := v >> 32; x > 0; x >>= 8 {
+		l++
+	}
+
+	b := make([]byte, l)
+	for i := l - 1; i >= 0; i-- {
+		b[i] = byte(v)
+		v >>= 8
+	}
+
+	return b
+}
+
 // Gen provides a wrapper for generating Frames
 func Gen(n, d string, s int) func() IFrame {
 	return func() IFrame {
@@ -179,9 +450,8 @@ func Gen(n, d string, s int) func() IFrame {
 
 // NewFrame provides a fresh instance of a frame
 func NewFrame(n, d string, s int) IFrame {
-	a, ok := frameInst[n]
+	a, ok := factory(n, s)
 	if !ok {
-		fmt.Printf("Unknown frame [%s]\n", n)
 		return nil
 	}
 
@@ -213,3 +483,8 @@ func (f *Frame) GetLength() int {
 func (f *Frame) GetExplain() string {
 	return f.Description
 }
+
+// GetBase provides the shared Frame detail underneath a specific Frame type
+func (f *Frame) GetBase() *Frame {
+	return f
+}
@@ -1,6 +1,10 @@
 package frames
 
-import "testing"
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
 
 func TestOrphanFuncs(t *testing.T) {
 	str := []byte{'a', 'b', 'c'}
@@ -74,6 +78,53 @@ func TestBustedString(t *testing.T) {
 	}
 }
 
+func TestLatin1(t *testing.T) {
+	b := []byte("Caf\xe9 \xa9 \xff\x00")
+
+	expected := "Café © ÿ"
+	found := GetLatin1(b)
+	if found != expected {
+		t.Fatalf("Got [%s], Expected [%s]", found, expected)
+	}
+
+	if !bytes.Equal(PutLatin1("Café © ÿ"), b[:len(b)-1]) {
+		t.Fatalf("Got [%#v], Expected [%#v]", PutLatin1("Café © ÿ"), b[:len(b)-1])
+	}
+
+	if !bytes.Equal(PutLatin1("日本"), []byte("??")) {
+		t.Fatalf("Got [%#v], Expected [??]", PutLatin1("日本"))
+	}
+}
+
+func TestEncodedStrings(t *testing.T) {
+	cases := []struct {
+		enc      byte
+		data     string
+		expected string
+		rest     string
+	}{
+		{EncodingLatin1, "Caf\xe9\x00rest", "Café", "rest"},
+		{EncodingUTF16, "\xff\xfeA\x00\x00\x00rest", "A", "rest"},
+		{EncodingUTF16, "\xfe\xff\x00A\x00\x00rest", "A", "rest"},
+		{EncodingUTF16, "\x00A\x00B", "AB", ""},
+		{EncodingUTF16BE, "\x00A\x01\x00\x00\x00rest", "AĀ", "rest"},
+		{EncodingUTF8, "Caf\xc3\xa9\x00rest", "Café", "rest"},
+		{EncodingUTF16, "\xfe\xff\x00A\x00", "A", ""},
+		{EncodingUTF16, "", "", ""},
+	}
+
+	for _, c := range cases {
+		found, rest := GetTermStr(c.enc, []byte(c.data))
+		if found != c.expected || string(rest) != c.rest {
+			t.Errorf("Got [%s] [%s], Expected [%s] [%s]", found, rest, c.expected, c.rest)
+		}
+	}
+
+	if GetEncoding('\x09') != EncodingLatin1 {
+		t.Error("Expected unknown encodings to fall back to latin")
+	}
+}
+
 func TestBaseGenFunc(t *testing.T) {
 	f := Gen("TPE1", "", Version4)()
 
@@ -83,3 +134,145 @@ func TestBaseGenFunc(t *testing.T) {
 		t.Fatalf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func TestPutSize(t *testing.T) {
+	expected := []byte{'\x00', '\x00', '\x04', '\x23'}
+	found := PutSize(1059, 4, 8)
+	if !bytes.Equal(found, expected) {
+		t.Fatalf("Got [%#v], Expected [%#v]", found, expected)
+	}
+
+	expected = []byte{'\x00', '\x00', '\x08', '\x23'}
+	found = PutSize(1059, 4, 7)
+	if !bytes.Equal(found, expected) {
+		t.Fatalf("Got [%#v], Expected [%#v]", found, expected)
+	}
+
+	if GetSize(found, 7) != 1059 {
+		t.Fatalf("Expected [1059] from synchsafe round trip, got [%d]", GetSize(found, 7))
+	}
+}
+
+func TestIsSynchsafe(t *testing.T) {
+	if !IsSynchsafe(PutSize(1<<28-1, 4, 7)) {
+		t.Fatalf("Expected a synchsafe size to be recognised")
+	}
+	if IsSynchsafe([]byte{'\x00', '\x00', '\x00', '\x80'}) {
+		t.Fatalf("Expected a plain size to not be synchsafe")
+	}
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	cases := []struct {
+		name string
+		data string
+	}{
+		{"AENC", "http://x\x00\x00\x01\x00\x02\xaa\xbb"},
+		{"APIC", "\x00image/jpeg\x00\x03Cover\x00\x01\x02\x03"},
+		{"APIC", "\x01image/png\x00\x04\xfe\xff\x00B\x00o\x00b\x00\x00\x01\x02"},
+		{"ASPI", "\x00\x00\x00\x10\x00\x00\x01\x00\x00\x02\x08\x05\x06"},
+		{"COMM", "\x00engDesc\x00A comment"},
+		{"COMM", "\x01eng\xfe\xff\x00D\x00\x00\xfe\xff\x00C"},
+		{"COMR", "\x00aud888.88\x0020200101http://example.com\x00\x00Bob\x00Thing\x00image/jpeg\x00\x01\x02"},
+		{"CRM", "\x00Bob\x00Explained\x00\x01\x02"},
+		{"ENCR", "Bob\x00\x81\x01\x02"},
+		{"EQU2", "\x01Bob\x00\x00\x64\x02\x00"},
+		{"EQUA", "\x10\x01\x02\x03\x04"},
+		{"ETCO", "\x01\x02\x00\x00\x03\xa4"},
+		{"GEOB", "\x00text/plain\x00file.txt\x00Desc\x00data"},
+		{"GRID", "Bob\x00\x81\x01\x02"},
+		{"IPLS", "\x00Dancer\x00Bill\x00Producer\x00Bob Down"},
+		{"LINK", "AENhttp://example.com\x00extra"},
+		{"MCDI", "\x35\x35\x35\x35\x66\x66\x66\x67\x76\x64\x46\x99\xa1\x33\x88\x98\x44\x56\x23\x43"},
+		{"MLLT", "\x00\x01\x00\x03\x00\x00\x30\x00\x02\x04\x44\x55\x66\x74"},
+		{"OWNE", "\x00AUD12.50\x0020200101Bob"},
+		{"PCNT", "\x00\x00\x01\x00"},
+		{"POPM", "bob@example.com\x00\xff\x00\x00\x00\x10"},
+		{"POSS", "\x02\x00\x00\x10\x00"},
+		{"PRIV", "owner\x00\x01\x02"},
+		{"RBUF", "\x00\x01\x34\x01\x00\x00\x01\x34"},
+		{"RVA2", "Bob\x00\x01\x02\x0a\x08\x35"},
+		{"RVAD", "\xc0\x10\x02\x00\x01\x00\x00\x80\x00\x40"},
+		{"RVRB", "\x00\x20\x00\x20\x03\x03\x80\x80\xff\x00\x80\x80"},
+		{"SEEK", "\x00\x00\x10\x00"},
+		{"SIGN", "\x81\x01\x02"},
+		{"SYLT", "\x00eng\x02\x01Lyrics\x00Bob\x00\x00\x00\x00\x35Down\x00\x00\x00\x01\x56"},
+		{"SYTC", "\x01\xff\x05\x00\x00\x10\x78\x00\x01\x00"},
+		{"TEXT", "\x00Hello, Bob"},
+		{"TPE1", "\x00Bj\xf6rk"},
+		{"TPE2", "\x02\x00B\x00j\x00\xf6\x00r\x00k"},
+		{"TPE3", "\x03Bj\xc3\xb6rk"},
+		{"TXXX", "\x02\x00T\x00\x00\x00V"},
+		{"TXXX", "\x03T\xc3\xa9\x00V\xc3\xa9"},
+		{"TIT2", "\x01\xfe\xff\x00H\x00i"},
+		{"TXXX", "\x00Type\x00Value"},
+		{"UFID", "http://example.com\x00\x01\x02\x03"},
+		{"USER", "\x00engTerms here"},
+		{"USLT", "\x00engDesc\x00Lyrics here"},
+		{"WOAF", "http://example.com"},
+		{"WXXX", "\x00Title\x00http://example.com"},
+	}
+
+	for _, c := range cases {
+		b := []byte(c.data)
+		x := NewFrame(c.name, "", Version3)
+		x.ProcessData(len(b), b)
+
+		found := x.Marshal()
+		if !bytes.Equal(found, b) {
+			t.Errorf("[%s] Got [%#v], Expected [%#v]", c.name, found, b)
+			continue
+		}
+
+		y := NewFrame(c.name, "", Version3)
+		y.ProcessData(len(found), found)
+		if !reflect.DeepEqual(x, y) {
+			t.Errorf("[%s] Got [%#v], Expected [%#v]", c.name, y, x)
+		}
+	}
+}
+
+func TestUnsynchronisation(t *testing.T) {
+	cases := []struct {
+		raw    string
+		synced string
+	}{
+		{"abc", "abc"},
+		{"\xff\xe0", "\xff\x00\xe0"},
+		{"\xff\x00", "\xff\x00\x00"},
+		{"\xff\x10", "\xff\x10"},
+		{"a\xff", "a\xff\x00"},
+		{"\xff\xff\xfb", "\xff\x00\xff\x00\xfb"},
+	}
+
+	for _, c := range cases {
+		if found := Unsync([]byte(c.raw)); !bytes.Equal(found, []byte(c.synced)) {
+			t.Fatalf("Got [%#v], Expected [%#v]", found, []byte(c.synced))
+		}
+		if found := Desync([]byte(c.synced)); !bytes.Equal(found, []byte(c.raw)) {
+			t.Fatalf("Got [%#v], Expected [%#v]", found, []byte(c.raw))
+		}
+	}
+}
+
+// fuzzFrame will feed content to the named frame at every version, ensuring that
+// processing, displaying and marshalling the frame never panics
+func fuzzFrame(f *testing.F, name string, seeds ...string) {
+	f.Add([]byte{})
+	for _, s := range seeds {
+		f.Add([]byte(s))
+	}
+
+	f.Fuzz(func(t *testing.T, d []byte) {
+		for _, v := range []int{Version2, Version3, Version4} {
+			fr := NewFrame(name, "", v)
+			if out, _ := Process(fr, len(d), d); out != fr {
+				t.Fatalf("Expected the frame to be given for [%s]", name)
+			}
+
+			_ = fr.DisplayContent()
+			m := fr.Marshal()
+			_, _ = Process(NewFrame(name, "", v), len(m), m)
+		}
+	})
+}
@@ -6,12 +6,14 @@ var (
 		"APIC": func() IFrame { return new(APIC) },
 		"ASPI": func() IFrame { return new(ASPI) },
 		"BUF":  func() IFrame { return new(RBUF) },
+		"CHAP": func() IFrame { return new(CHAP) },
 		"CNT":  func() IFrame { return new(PCNT) },
 		"COM":  func() IFrame { return new(COMM) },
 		"COMM": func() IFrame { return new(COMM) },
 		"COMR": func() IFrame { return new(COMR) },
 		"CRA":  func() IFrame { return new(AENC) },
 		"CRM":  func() IFrame { return new(CRM) },
+		"CTOC": func() IFrame { return new(CTOC) },
 		"ENCR": func() IFrame { return new(ENCR) },
 		"EQU":  func() IFrame { return new(EQUA) },
 		"EQU2": func() IFrame { return new(EQU2) },
@@ -31,7 +33,7 @@ var (
 		"MLLT": func() IFrame { return new(MLLT) },
 		"OWNE": func() IFrame { return new(OWNE) },
 		"PCNT": func() IFrame { return new(PCNT) },
-		"PIC":  func() IFrame { return new(APIC) },
+		"PIC":  func() IFrame { return new(PIC) },
 		"POP":  func() IFrame { return new(POPM) },
 		"POPM": func() IFrame { return new(POPM) },
 		"POSS": func() IFrame { return new(POSS) },
@@ -3,6 +3,7 @@ package frames
 var (
 	// Version22Frames defines the version 2.2 frame mapping
 	// http://id3.org/id3v2-00
+	// Frames given through Register are held apart, leaving this mapping unchanged
 	Version22Frames = map[string]func() IFrame{
 		"BUF": Gen("BUF", "Recommended buffer size", Version2),
 		"CNT": Gen("CNT", "Play counter", Version2),
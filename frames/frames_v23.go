@@ -3,11 +3,14 @@ package frames
 var (
 	// Version23Frames defines the version 2.3 frame mapping
 	// http://id3.org/id3v2.3.0
+	// Frames given through Register are held apart, leaving this mapping unchanged
 	Version23Frames = map[string]func() IFrame{
 		"AENC": Gen("AENC", "Audio encryption", Version3),
 		"APIC": Gen("APIC", "Attached picture", Version3),
+		"CHAP": Gen("CHAP", "Chapter", Version3),
 		"COMM": Gen("COMM", "User comment", Version3),
 		"COMR": Gen("COMR", "Commercial frame", Version3),
+		"CTOC": Gen("CTOC", "Table of contents", Version3),
 		"ENCR": Gen("ENCR", "Encryption method registration", Version3),
 		"EQUA": Gen("EQUA", "Equalization", Version3),
 		"ETCO": Gen("ETCO", "Event timing codes", Version3),
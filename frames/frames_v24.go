@@ -3,12 +3,15 @@ package frames
 var (
 	// Version24Frames defines the version 2.4 frame mapping
 	// http://id3.org/id3v2.4.0-frames
+	// Frames given through Register are held apart, leaving this mapping unchanged
 	Version24Frames = map[string]func() IFrame{
 		"AENC": Gen("AENC", "Audio encryption", Version4),
 		"APIC": Gen("APIC", "Attached picture", Version4),
 		"ASPI": Gen("ASPI", "Audio seek point index", Version4),
+		"CHAP": Gen("CHAP", "Chapter", Version4),
 		"COMM": Gen("COMM", "Comments", Version4),
 		"COMR": Gen("COMR", "Commercial frame", Version4),
+		"CTOC": Gen("CTOC", "Table of contents", Version4),
 		"ENCR": Gen("ENCR", "Encryption method registration", Version4),
 		"EQUA": Gen("EQUA", "Equalization", Version4),
 		"EQU2": Gen("EQU2", "Equalisation (2)", Version4),
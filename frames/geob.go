@@ -1,9 +1,6 @@
 package frames
 
-import (
-	"bytes"
-	"fmt"
-)
+import "fmt"
 
 // GEOB is a general encapsulated object frame
 type GEOB struct {
@@ -27,32 +24,26 @@ func (g *GEOB) ProcessData(s int, d []byte) IFrame {
 	g.Size = s
 	g.Data = d
 
-	if d[0] == '\x01' {
-		g.Utf16 = true
-	}
-	d = d[1:]
-
-	idx := bytes.IndexByte(d, '\x00')
-	g.MimeType = GetStr(d[:idx])
-	d = d[idx+1:]
-
-	if !g.Utf16 {
-		idx = bytes.IndexByte(d, '\x00')
-		g.ExternalFilename = GetStr(d[:idx])
-		d = d[idx+LengthStandard:]
-
-		idx = bytes.IndexByte(d, '\x00')
-		g.ContentDescription = GetStr(d[:idx])
-		g.Object = d[idx+LengthStandard:]
-	} else {
-		idx = bytes.Index(d, []byte{'\x00', '\x00'})
-		g.ExternalFilename = GetUnicodeStr(d[:idx])
-		d = d[idx+LengthUnicode:]
-
-		idx = bytes.Index(d, []byte{'\x00', '\x00'})
-		g.ContentDescription = GetUnicodeStr(d[:idx])
-		g.Object = d[idx+LengthUnicode:]
+	if len(d) < 1 {
+		g.fail(ErrTruncated, "has no content")
+		return g
 	}
 
+	g.Encoding = GetEncoding(d[0])
+	g.MimeType, d = GetTermStr(EncodingLatin1, d[1:])
+
+	g.ExternalFilename, d = GetTermStr(g.Encoding, d)
+	g.ContentDescription, g.Object = GetTermStr(g.Encoding, d)
+
 	return g
 }
+
+// Marshal will serialise the frame content for writing
+func (g *GEOB) Marshal() []byte {
+	b := []byte{g.Encoding}
+	b = append(b, PutTermStr(g.MimeType, EncodingLatin1)...)
+	b = append(b, PutTermStr(g.ExternalFilename, g.Encoding)...)
+	b = append(b, PutTermStr(g.ContentDescription, g.Encoding)...)
+
+	return append(b, g.Object...)
+}
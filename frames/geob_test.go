@@ -34,3 +34,17 @@ func TestGeobUtf16(t *testing.T) {
 		t.Errorf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func TestGeobLatin1(t *testing.T) {
+	x := NewFrame("GEOB", "", Version3).(*GEOB)
+	b := []byte("\x00text/plain\x00r\xe9sum\xe9.txt\x00Le r\xe9sum\xe9\x00data")
+
+	x.ProcessData(len(b), b)
+	if x.ExternalFilename != "résumé.txt" || x.ContentDescription != "Le résumé" {
+		t.Fatalf("Got [%s] [%s], Expected [résumé.txt] [Le résumé]", x.ExternalFilename, x.ContentDescription)
+	}
+}
+
+func FuzzGeobProcess(f *testing.F) {
+	fuzzFrame(f, "GEOB", "\x00text/plain\x00file.txt\x00desc\x00object")
+}
@@ -25,9 +25,22 @@ func (g *GRID) ProcessData(s int, d []byte) IFrame {
 	g.Data = d
 
 	idx := bytes.IndexByte(d, '\x00')
-	g.Owner = GetStr(d[:idx])
+	if idx < 0 || len(d) < idx+2 {
+		g.fail(ErrTruncated, "group symbol is missing")
+		return g
+	}
+
+	g.Owner = GetLatin1(d[:idx])
 	g.Symbol = d[idx+1]
 	g.DependantData = d[idx+2:]
 
 	return g
 }
+
+// Marshal will serialise the frame content for writing
+func (g *GRID) Marshal() []byte {
+	b := PutTermStr(g.Owner, EncodingLatin1)
+	b = append(b, g.Symbol)
+
+	return append(b, g.DependantData...)
+}
@@ -25,3 +25,7 @@ func TestGridBasicOutput(t *testing.T) {
 		t.Errorf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func FuzzGridProcess(f *testing.F) {
+	fuzzFrame(f, "GRID", "owner\x00\x80data")
+}
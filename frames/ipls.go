@@ -1,7 +1,6 @@
 package frames
 
 import (
-	"bytes"
 	"fmt"
 	"sort"
 )
@@ -41,50 +40,22 @@ func (i *IPLS) ProcessData(s int, d []byte) IFrame {
 	k := []string{}
 	t := map[string]string{}
 
-	if d[0] == '\x01' {
-		i.Utf16 = true
+	if len(d) < 1 {
+		i.fail(ErrTruncated, "has no content")
+		return i
 	}
+
+	i.Encoding = GetEncoding(d[0])
 	d = d[1:]
 
 	// loop through lines, should be even numbered
 	for len(d) > 2 {
-		if !i.Utf16 {
-			idx := bytes.IndexByte(d, '\x00')
-			name := GetStr(d[:idx])
-			d = d[idx+LengthStandard:]
-
-			idx = bytes.IndexByte(d, '\x00')
-			if idx == -1 {
-				value := GetStr(d)
-				k = append(k, name)
-				t[name] = value
-
-				break
-			}
-			value := GetStr(d[:idx])
-			k = append(k, name)
-			t[name] = value
-
-			d = d[idx+LengthStandard:]
-		} else {
-			idx := bytes.Index(d, []byte{'\x00', '\x00'})
-			name := GetUnicodeStr(d[:idx])
-			d = d[idx+LengthUnicode:]
-
-			idx = bytes.Index(d, []byte{'\x00', '\x00'})
-			if idx == -1 {
-				value := GetUnicodeStr(d)
-				k = append(k, name)
-				t[name] = value
-
-				break
-			}
-			value := GetUnicodeStr(d[:idx])
-			k = append(k, name)
-			t[name] = value
-
-			d = d[idx+LengthUnicode:]
-		}
+		var name, value string
+		name, d = GetTermStr(i.Encoding, d)
+		value, d = GetTermStr(i.Encoding, d)
+
+		k = append(k, name)
+		t[name] = value
 	}
 
 	sort.Strings(k)
@@ -96,3 +67,24 @@ func (i *IPLS) ProcessData(s int, d []byte) IFrame {
 
 	return i
 }
+
+// Marshal will serialise the frame content for writing
+func (i *IPLS) Marshal() []byte {
+	k := []string{}
+	for x := range i.People {
+		k = append(k, x)
+	}
+	sort.Strings(k)
+
+	b := []byte{i.Encoding}
+	for n, x := range k {
+		b = append(b, PutTermStr(x, i.Encoding)...)
+		if n == len(k)-1 {
+			b = append(b, PutStr(i.People[x], i.Encoding)...)
+		} else {
+			b = append(b, PutTermStr(i.People[x], i.Encoding)...)
+		}
+	}
+
+	return b
+}
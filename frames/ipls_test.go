@@ -57,3 +57,7 @@ func TestIplsSimpleOutput(t *testing.T) {
 		t.Fatalf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func FuzzIplsProcess(f *testing.F) {
+	fuzzFrame(f, "IPLS", "\x00role\x00name\x00")
+}
@@ -1,9 +1,6 @@
 package frames
 
-import (
-	"bytes"
-	"fmt"
-)
+import "fmt"
 
 // LINK provides linked information for the file
 type LINK struct {
@@ -24,12 +21,24 @@ func (l *LINK) ProcessData(s int, d []byte) IFrame {
 	l.Size = s
 	l.Data = d
 
+	if len(d) < 3 {
+		l.fail(ErrTruncated, "frame identifier is missing")
+		return l
+	}
+
 	l.Identifier = d[:3]
-	d = d[3:]
 
-	idx := bytes.IndexByte(d, '\x00')
-	l.URL = GetStr(d[:idx])
-	l.AdditionalData = GetStr(d[idx+1:])
+	var rest []byte
+	l.URL, rest = GetTermStr(EncodingLatin1, d[3:])
+	l.AdditionalData = GetLatin1(rest)
 
 	return l
 }
+
+// Marshal will serialise the frame content for writing
+func (l *LINK) Marshal() []byte {
+	b := append([]byte{}, l.Identifier...)
+	b = append(b, PutTermStr(l.URL, EncodingLatin1)...)
+
+	return append(b, PutStr(l.AdditionalData, EncodingLatin1)...)
+}
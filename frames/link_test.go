@@ -36,3 +36,7 @@ func TestLinkParseV3(t *testing.T) {
 		t.Fatalf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func FuzzLinkProcess(f *testing.F) {
+	fuzzFrame(f, "LINK", "TIThttp://x\x00extra")
+}
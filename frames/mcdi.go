@@ -50,3 +50,13 @@ func (m *MCDI) ProcessData(s int, d []byte) IFrame {
 
 	return m
 }
+
+// Marshal will serialise the frame content for writing
+func (m *MCDI) Marshal() []byte {
+	b := append([]byte{}, m.DiscHeader...)
+	for _, v := range m.Tracks {
+		b = append(b, v...)
+	}
+
+	return b
+}
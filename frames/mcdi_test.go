@@ -44,3 +44,7 @@ func TestMcdiParseV3(t *testing.T) {
 		t.Fatalf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func FuzzMcdiProcess(f *testing.F) {
+	fuzzFrame(f, "MCDI", "\x00\x01\x02\x03")
+}
@@ -1,40 +1,234 @@
 package frames
 
-import "fmt"
+import (
+	"fmt"
+	"math/bits"
+	"time"
+)
 
-// MLLT defines the mpeg location lookup table
+const (
+	mlltHeaderLength = 10
+	mlltMaxBits      = 32 // widest deviation held, keeping the values within an int
+)
+
+// MLLT defines the mpeg location lookup table. References are given at a fixed
+// interval of frames, each being the given bytes and milliseconds along from the
+// last once its deviations are added.
 type MLLT struct {
 	Frame
 
-	FramesBetween       []byte `json:"frames_between"`
-	BytesBetween        []byte `json:"bytes_between"`
-	MillisecondsBetween []byte `json:"milliseconds_between"`
-	BitsForBytes        byte   `json:"bits_for_bytes"`
-	BitsForMilliseconds byte   `json:"bits_for_milliseconds"`
-	Deviations          []byte `json:"deviations"`
+	FramesBetween       int          `json:"frames_between"`
+	BytesBetween        int          `json:"bytes_between"`
+	MillisecondsBetween int          `json:"milliseconds_between"`
+	BitsForBytes        byte         `json:"bits_for_bytes"`
+	BitsForMilliseconds byte         `json:"bits_for_milliseconds"`
+	Deviations          []*Deviation `json:"deviations"`
+}
+
+// Deviation is the amount a reference within the lookup table is beyond the fixed
+// interval from the one before it
+type Deviation struct {
+	Bytes        int `json:"bytes"`
+	Milliseconds int `json:"milliseconds"`
+}
+
+// MPEGFrame is an MPEG audio frame found by scanning the audio, with its length in
+// bytes and how long it plays for
+type MPEGFrame struct {
+	Size     int
+	Duration time.Duration
+}
+
+// NewMLLT provides a lookup table for the scanned frames, placing a reference after
+// each interval of frames. The fixed intervals are the smallest found between the
+// references, leaving each deviation to be positive as the spec requires.
+func NewMLLT(v, framesBetween int, scanned []MPEGFrame) *MLLT {
+	n := "MLLT"
+	if v == Version2 {
+		n = "MLL"
+	}
+
+	m := &MLLT{}
+	m.Init(n, "MPEG location lookup table", v)
+	m.FramesBetween = framesBetween
+	m.Deviations = []*Deviation{}
+
+	if framesBetween < 1 {
+		return m
+	}
+
+	// gather the distance between each reference, rounding time to the millisecond
+	// from the start so that rounding doesn't drift
+	steps := []*Deviation{}
+	var at time.Duration
+	ms := 0
+	for i := 0; i+framesBetween <= len(scanned); i += framesBetween {
+		x := &Deviation{}
+		for _, f := range scanned[i : i+framesBetween] {
+			x.Bytes += f.Size
+			at += f.Duration
+		}
+
+		next := int((at + time.Millisecond/2) / time.Millisecond)
+		x.Milliseconds = next - ms
+		ms = next
+
+		steps = append(steps, x)
+	}
+	if len(steps) < 1 {
+		return m
+	}
+
+	m.BytesBetween, m.MillisecondsBetween = steps[0].Bytes, steps[0].Milliseconds
+	for _, x := range steps {
+		m.BytesBetween = min(m.BytesBetween, x.Bytes)
+		m.MillisecondsBetween = min(m.MillisecondsBetween, x.Milliseconds)
+	}
+
+	maxBytes, maxMs := 0, 0
+	for _, x := range steps {
+		x.Bytes -= m.BytesBetween
+		x.Milliseconds -= m.MillisecondsBetween
+		maxBytes = max(maxBytes, x.Bytes)
+		maxMs = max(maxMs, x.Milliseconds)
+	}
+
+	// the bits for both deviations must together be a multiple of four, which is
+	// taken to whole bytes so that padding isn't mistaken for a further reference
+	m.BitsForBytes = byte(bits.Len(uint(maxBytes)))
+	m.BitsForMilliseconds = byte(bits.Len(uint(maxMs)))
+	if r := (m.BitsForBytes + m.BitsForMilliseconds) % 8; r > 0 || m.BitsForBytes+m.BitsForMilliseconds == 0 {
+		m.BitsForBytes += 8 - r
+	}
+	m.Deviations = steps
+
+	return m
 }
 
 // DisplayContent will comprehensively display known information
 func (m *MLLT) DisplayContent() string {
-	return fmt.Sprintf("MPEG Lookup\n\tFrames: %d\n\tBytes: %d\n\tMilliseconds: %d\n",
+	return fmt.Sprintf("MPEG Lookup\n\tFrames: %d\n\tBytes: %d\n\tMilliseconds: %d\n\tReferences: %d\n",
 		m.FramesBetween,
 		m.BytesBetween,
-		m.MillisecondsBetween)
+		m.MillisecondsBetween,
+		len(m.Deviations))
 }
 
 // ProcessData will handle the acquisition of all data
 func (m *MLLT) ProcessData(s int, d []byte) IFrame {
 	m.Size = s
 	m.Data = d
+	m.Deviations = []*Deviation{}
 
-	if m.Size > 10 {
-		m.FramesBetween = d[:2]
-		m.BytesBetween = d[2:5]
-		m.MillisecondsBetween = d[5:8]
-		m.BitsForBytes = d[8]
-		m.BitsForMilliseconds = d[9]
-		m.Deviations = d[10:]
+	if len(d) < mlltHeaderLength {
+		m.fail(ErrTruncated, "requires %d bytes, found [%d]", mlltHeaderLength, len(d))
+		return m
+	}
+
+	m.FramesBetween = GetSize(d[:2], 8)
+	m.BytesBetween = GetSize(d[2:5], 8)
+	m.MillisecondsBetween = GetSize(d[5:8], 8)
+	m.BitsForBytes = d[8]
+	m.BitsForMilliseconds = d[9]
+	d = d[mlltHeaderLength:]
+
+	bb, bm := int(m.BitsForBytes), int(m.BitsForMilliseconds)
+	if bb > mlltMaxBits || bm > mlltMaxBits {
+		m.fail(ErrInvalid, "deviations of [%d] and [%d] bits exceed [%d]", bb, bm, mlltMaxBits)
+		return m
+	}
+	if bb+bm == 0 {
+		return m
+	}
+
+	// deviations are bit packed pairs, with the last byte padded out
+	r := &bitReader{d: d}
+	for n := len(d) * 8 / (bb + bm); n > 0; n-- {
+		m.Deviations = append(m.Deviations, &Deviation{
+			Bytes:        r.read(bb),
+			Milliseconds: r.read(bm),
+		})
 	}
 
 	return m
 }
+
+// Marshal will serialise the frame content for writing
+func (m *MLLT) Marshal() []byte {
+	b := PutSize(m.FramesBetween, 2, 8)
+	b = append(b, PutSize(m.BytesBetween, 3, 8)...)
+	b = append(b, PutSize(m.MillisecondsBetween, 3, 8)...)
+	b = append(b, m.BitsForBytes, m.BitsForMilliseconds)
+
+	w := &bitWriter{}
+	for _, v := range m.Deviations {
+		w.write(v.Bytes, int(m.BitsForBytes))
+		w.write(v.Milliseconds, int(m.BitsForMilliseconds))
+	}
+
+	return append(b, w.d...)
+}
+
+// Lookup provides the approximate byte offset of the audio at the time, measured
+// from the first MPEG frame. Offsets are interpolated between the references, and
+// carried on at the fixed interval beyond the last of them.
+func (m *MLLT) Lookup(ms int) int {
+	if ms <= 0 {
+		return 0
+	}
+
+	pos, at := 0, 0
+	for _, v := range m.Deviations {
+		nextPos := pos + m.BytesBetween + v.Bytes
+		nextAt := at + m.MillisecondsBetween + v.Milliseconds
+		if ms < nextAt {
+			return pos + (ms-at)*(nextPos-pos)/(nextAt-at)
+		}
+
+		pos, at = nextPos, nextAt
+	}
+
+	if m.MillisecondsBetween < 1 {
+		return pos
+	}
+
+	return pos + (ms-at)*m.BytesBetween/m.MillisecondsBetween
+}
+
+// bitReader reads values from the most significant bit onwards
+type bitReader struct {
+	d   []byte
+	bit int
+}
+
+func (r *bitReader) read(n int) int {
+	v := 0
+	for ; n > 0; n-- {
+		v <<= 1
+		if r.bit/8 < len(r.d) && r.d[r.bit/8]&(0x80>>(r.bit%8)) != 0 {
+			v |= 1
+		}
+		r.bit++
+	}
+
+	return v
+}
+
+// bitWriter packs values from the most significant bit onwards, with the last byte
+// padded by zero bits
+type bitWriter struct {
+	d   []byte
+	bit int
+}
+
+func (w *bitWriter) write(v, n int) {
+	for n--; n >= 0; n-- {
+		if w.bit%8 == 0 {
+			w.d = append(w.d, 0)
+		}
+		if v>>n&1 == 1 {
+			w.d[len(w.d)-1] |= 0x80 >> (w.bit % 8)
+		}
+		w.bit++
+	}
+}
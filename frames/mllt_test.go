@@ -1,6 +1,9 @@
 package frames
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestMlltGeneral(t *testing.T) {
 	x := NewFrame("MLLT", "MPEG Location Lookup Table", Version3).(*MLLT)
@@ -35,9 +38,83 @@ func TestMlltParseV3(t *testing.T) {
 		"\x44\x55\x66\x77")
 	x.ProcessData(len(b), b)
 
-	expected := "MPEG Lookup\n\tFrames: [0 1]\n\tBytes: [0 3 0]\n\tMilliseconds: [0 48 0]\n"
+	expected := "MPEG Lookup\n\tFrames: 1\n\tBytes: 768\n\tMilliseconds: 12288\n\tReferences: 5\n"
 	found := x.DisplayContent()
 	if found != expected {
 		t.Fatalf("Got [%s], Expected [%s]", found, expected)
 	}
+
+	deviations := []Deviation{{1, 1}, {0, 5}, {1, 5}, {2, 6}, {1, 13}}
+	for i, v := range deviations {
+		if *x.Deviations[i] != v {
+			t.Fatalf("Got [%v], Expected [%v]", *x.Deviations[i], v)
+		}
+	}
+
+	// the trailing padding bits are written as zero
+	m := string(x.Marshal())
+	if m != string(b[:len(b)-1])+"\x74" {
+		t.Fatalf("Got [%#v], Expected [%#v]", m, string(b[:len(b)-1])+"\x74")
+	}
+}
+
+func TestMlltInvalid(t *testing.T) {
+	for _, v := range []string{"\x00\x01\x00", "\x00\x01\x00\x00\x10\x00\x00\x20\x21\x08\x12"} {
+		if _, err := Process(NewFrame("MLLT", "", Version3), len(v), []byte(v)); err == nil {
+			t.Fatalf("Expected an error for [%#v]", v)
+		}
+	}
+}
+
+func TestMlltLookup(t *testing.T) {
+	x := &MLLT{
+		FramesBetween:       1,
+		BytesBetween:        100,
+		MillisecondsBetween: 10,
+		Deviations:          []*Deviation{{0, 0}, {20, 2}, {0, 0}},
+	}
+
+	for ms, expected := range map[int]int{-5: 0, 0: 0, 5: 50, 10: 100, 16: 160, 22: 220, 32: 320, 42: 420} {
+		if found := x.Lookup(ms); found != expected {
+			t.Fatalf("Got [%d], Expected [%d] for [%dms]", found, expected, ms)
+		}
+	}
+
+	x.MillisecondsBetween = 0
+	x.Deviations = nil
+	if found := x.Lookup(100); found != 0 {
+		t.Fatalf("Got [%d], Expected [0]", found)
+	}
+}
+
+func TestNewMllt(t *testing.T) {
+	d := 1152 * time.Second / 44100
+	scanned := []MPEGFrame{{417, d}, {418, d}, {417, d}, {418, d}, {418, d}, {418, d}, {417, d}}
+
+	x := NewMLLT(Version3, 2, scanned)
+	if x.GetName() != "MLLT" || x.BytesBetween != 835 || x.MillisecondsBetween != 52 || len(x.Deviations) != 3 {
+		t.Fatalf("Got [%s], Expected a table of 3 references", x.DisplayContent())
+	}
+	if (x.BitsForBytes+x.BitsForMilliseconds)%4 != 0 || *x.Deviations[2] != (Deviation{1, 1}) {
+		t.Fatalf("Got [%d, %d, %v], Expected bits in a multiple of four", x.BitsForBytes, x.BitsForMilliseconds, *x.Deviations[2])
+	}
+
+	if found := x.Lookup(157); found != 2506 {
+		t.Fatalf("Got [%d], Expected [2506]", found)
+	}
+
+	b := x.Marshal()
+	y := NewFrame("MLLT", "", Version3).(*MLLT)
+	y.ProcessData(len(b), b)
+	if y.DisplayContent() != x.DisplayContent() || y.Lookup(130) != x.Lookup(130) {
+		t.Fatalf("Got [%s], Expected [%s]", y.DisplayContent(), x.DisplayContent())
+	}
+
+	if x = NewMLLT(Version2, 8, scanned); x.GetName() != "MLL" || len(x.Deviations) != 0 {
+		t.Fatalf("Got [%s], Expected an empty table", x.DisplayContent())
+	}
+}
+
+func FuzzMlltProcess(f *testing.F) {
+	fuzzFrame(f, "MLLT", "\x00\x01\x00\x00\x10\x00\x00 \x08\x08\x12")
 }
@@ -1,9 +1,6 @@
 package frames
 
-import (
-	"bytes"
-	"fmt"
-)
+import "fmt"
 
 // OWNE is the ownership frame
 type OWNE struct {
@@ -29,20 +26,32 @@ func (o *OWNE) ProcessData(s int, d []byte) IFrame {
 	o.Size = s
 	o.Data = d
 
-	o.Utf16 = GetBoolBit(d[0], 0)
-	o.Currency = GetStr(d[1:4])
+	if len(d) < 4 {
+		o.fail(ErrTruncated, "currency is missing")
+		return o
+	}
 
-	d = d[4:]
-	idx := bytes.IndexByte(d, '\x00')
-	o.Paid = GetStr(d[:idx])
-	d = d[idx+1:]
+	o.Encoding = GetEncoding(d[0])
+	o.Currency = GetLatin1(d[1:4])
+	o.Paid, d = GetTermStr(EncodingLatin1, d[4:])
 
-	o.PurchaseDate = GetStr(d[:8])
-	if !o.Utf16 {
-		o.Seller = GetStr(d[8:])
-	} else {
-		o.Seller = GetUnicodeStr(d[8:])
+	if len(d) < 8 {
+		o.fail(ErrTruncated, "purchase date is missing")
+		return o
 	}
 
+	o.PurchaseDate = GetLatin1(d[:8])
+	o.Seller = GetEncStr(o.Encoding, d[8:])
+
 	return o
 }
+
+// Marshal will serialise the frame content for writing
+func (o *OWNE) Marshal() []byte {
+	b := []byte{o.Encoding}
+	b = append(b, putFixed(o.Currency, 3)...)
+	b = append(b, PutTermStr(o.Paid, EncodingLatin1)...)
+	b = append(b, putFixed(o.PurchaseDate, 8)...)
+
+	return append(b, PutStr(o.Seller, o.Encoding)...)
+}
@@ -48,3 +48,7 @@ func TestOwneParseUtf16(t *testing.T) {
 		t.Fatalf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func FuzzOwneProcess(f *testing.F) {
+	fuzzFrame(f, "OWNE", "\x00USD1.00\x0020201231seller")
+}
@@ -6,7 +6,7 @@ import "fmt"
 type PCNT struct {
 	Frame
 
-	Count int `json:"count"`
+	Count uint64 `json:"count"`
 }
 
 // DisplayContent will comprehensively display known information
@@ -19,7 +19,12 @@ func (p *PCNT) ProcessData(s int, d []byte) IFrame {
 	p.Size = s
 	p.Data = d
 
-	p.Count = GetSize(p.Data, 8)
+	p.Count = getCounter(p.Data)
 
 	return p
 }
+
+// Marshal will serialise the frame content for writing
+func (p *PCNT) Marshal() []byte {
+	return putCounter(p.Count)
+}
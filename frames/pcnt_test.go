@@ -46,3 +46,26 @@ func TestPcntProcess(t *testing.T) {
 		t.Fatalf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func TestPcntExtended(t *testing.T) {
+	x := NewFrame("PCNT", "", Version4).(*PCNT)
+	b := []byte("\x01\x00\x00\x00\x02")
+
+	x.ProcessData(len(b), b)
+	if x.Count != 1<<32+2 {
+		t.Fatalf("Got [%d], Expected [%d]", x.Count, uint64(1<<32+2))
+	}
+
+	if m := string(x.Marshal()); m != string(b) {
+		t.Fatalf("Got [%#v], Expected [%#v]", m, string(b))
+	}
+
+	x.Count = 7
+	if m := string(x.Marshal()); m != "\x00\x00\x00\x07" {
+		t.Fatalf("Got [%#v], Expected [%#v]", m, "\x00\x00\x00\x07")
+	}
+}
+
+func FuzzPcntProcess(f *testing.F) {
+	fuzzFrame(f, "PCNT", "\x00\x00\x00\x10")
+}
@@ -1,6 +1,98 @@
 package frames
 
-// PIC is for older picture embed frames
+import (
+	"fmt"
+	"strings"
+)
+
+// IPicture is held by the frames embedding a picture, giving access to the picture
+// regardless of the version it was found within
+type IPicture interface {
+	IFrame
+
+	GetPicture() *APIC
+}
+
+// PIC is the v2.2 attached picture, which gives a three character image format in
+// place of the MIME type. The format is normalised to a MIME type, so the picture
+// can be used just as those from APIC.
 type PIC struct {
-	*Frame
+	APIC
+
+	ImageFormat string `json:"image_format" yaml:"image_format"`
+}
+
+// picFormats maps the v2.2 image formats to their MIME type
+var picFormats = map[string]string{
+	"JPG": "image/jpeg",
+	"PNG": "image/png",
+	"GIF": "image/gif",
+	"BMP": "image/bmp",
+	"TIF": "image/tiff",
+	"-->": "-->",
+}
+
+// DisplayContent will comprehensively display known information for PIC
+func (p *PIC) DisplayContent() string {
+	return fmt.Sprintf("Image (%s, %s, %db) %s\n", p.MimeType, p.PictureType, len(p.Image), p.Title)
+}
+
+// ProcessData grabs the meta and binary detail for the image
+func (p *PIC) ProcessData(s int, d []byte) IFrame {
+	p.Size = s
+	p.Data = d
+
+	if len(d) < 5 {
+		p.fail(ErrTruncated, "image format and picture type are missing")
+		return p
+	}
+
+	// encoding, then the fixed length image format and picture type
+	p.Encoding = GetEncoding(d[0])
+	p.ImageFormat = GetLatin1(d[1:4])
+	p.MimeType = FormatMimeType(p.ImageFormat)
+	p.SetPictureType(PictureType(d[4]))
+
+	// image description, null term, with the image following
+	p.Title, p.Image = GetTermStr(p.Encoding, d[5:])
+	p.Size = len(p.Image)
+
+	return p
+}
+
+// Marshal will serialise the frame content for writing
+func (p *PIC) Marshal() []byte {
+	f := p.ImageFormat
+	if FormatMimeType(f) != p.MimeType {
+		f = MimeTypeFormat(p.MimeType)
+	}
+
+	b := []byte{p.Encoding}
+	b = append(b, PutLatin1(fmt.Sprintf("%-3.3s", f))...)
+	b = append(b, byte(p.PictureType))
+	b = append(b, PutTermStr(p.Title, p.Encoding)...)
+
+	return append(b, p.Image...)
+}
+
+// FormatMimeType normalises a v2.2 image format to the MIME type, with those not
+// known given as an image of the lower cased format
+func FormatMimeType(f string) string {
+	f = strings.ToUpper(strings.TrimRight(f, " \x00"))
+	if m, ok := picFormats[f]; ok {
+		return m
+	}
+
+	return "image/" + strings.ToLower(f)
+}
+
+// MimeTypeFormat provides the v2.2 image format for the MIME type
+func MimeTypeFormat(m string) string {
+	for k, v := range picFormats {
+		if v == m {
+			return k
+		}
+	}
+
+	return strings.ToUpper(strings.TrimPrefix(m, "image/"))
 }
@@ -0,0 +1,77 @@
+package frames
+
+import "testing"
+
+func TestPicProcess(t *testing.T) {
+	x := NewFrame("PIC", "Attached picture", Version2).(*PIC)
+	b := []byte("\x00JPG\x03Cover\x00\xff\xd8\xff")
+
+	x.ProcessData(len(b), b)
+	expected := "Image (image/jpeg, Cover (front), 3b) Cover\n"
+	found := x.DisplayContent()
+	if found != expected {
+		t.Fatalf("Got [%s], Expected [%s]", found, expected)
+	}
+
+	if x.ImageFormat != "JPG" {
+		t.Fatalf("Got [%s], Expected [JPG]", x.ImageFormat)
+	}
+
+	var p IFrame = x
+	if a, ok := p.(IPicture); !ok || a.GetPicture().MimeType != "image/jpeg" {
+		t.Fatal("Expected PIC to provide the picture")
+	}
+
+	if m := string(x.Marshal()); m != string(b) {
+		t.Fatalf("Got [%#v], Expected [%#v]", m, string(b))
+	}
+}
+
+func TestPicMarshalMimeType(t *testing.T) {
+	x := NewFrame("PIC", "Attached picture", Version2).(*PIC)
+	b := []byte("\x00gif\x00\x00\x01")
+
+	x.ProcessData(len(b), b)
+	if x.MimeType != "image/gif" {
+		t.Fatalf("Got [%s], Expected [image/gif]", x.MimeType)
+	}
+
+	x.MimeType = "image/png"
+	expected := "\x00PNG\x00\x00\x01"
+	if m := string(x.Marshal()); m != expected {
+		t.Fatalf("Got [%#v], Expected [%#v]", m, expected)
+	}
+
+	x.MimeType = "image/x-icon"
+	expected = "\x00X-I\x00\x00\x01"
+	if m := string(x.Marshal()); m != expected {
+		t.Fatalf("Got [%#v], Expected [%#v]", m, expected)
+	}
+}
+
+func TestPicTruncated(t *testing.T) {
+	x := NewFrame("PIC", "", Version2).(*PIC)
+	b := []byte("\x00PN")
+
+	if _, err := Process(x, len(b), b); err == nil {
+		t.Fatal("Expected an error for a truncated PIC")
+	}
+}
+
+func TestFormatMimeType(t *testing.T) {
+	for f, m := range map[string]string{"JPG": "image/jpeg", "png": "image/png", "-->": "-->", "WEB": "image/web"} {
+		if found := FormatMimeType(f); found != m {
+			t.Fatalf("Got [%s], Expected [%s]", found, m)
+		}
+	}
+
+	for m, f := range map[string]string{"image/jpeg": "JPG", "image/png": "PNG", "-->": "-->", "image/webp": "WEBP"} {
+		if found := MimeTypeFormat(m); found != f {
+			t.Fatalf("Got [%s], Expected [%s]", found, f)
+		}
+	}
+}
+
+func FuzzPicProcess(f *testing.F) {
+	fuzzFrame(f, "PIC", "\x00PNG\x03title\x00\x89PNG", "\x01JPG\x00\xff\xfet\x00\x00\x00\xff\xd8")
+}
@@ -11,7 +11,7 @@ type POPM struct {
 
 	Email      string `json:"email"`
 	Popularity int    `json:"popularity"`
-	Counter    int    `json:"counter"`
+	Counter    uint64 `json:"counter"`
 }
 
 // DisplayContent will comprehensively display known information
@@ -28,9 +28,22 @@ func (p *POPM) ProcessData(s int, d []byte) IFrame {
 	p.Data = d
 
 	idx := bytes.IndexByte(d, '\x00')
-	p.Email = GetStr(d[:idx])
+	if idx < 0 || len(d) < idx+2 {
+		p.fail(ErrTruncated, "rating is missing")
+		return p
+	}
+
+	p.Email = GetLatin1(d[:idx])
 	p.Popularity = GetDirectInt(d[idx+1])
-	p.Counter = GetSize(d[idx+2:], 8)
+	p.Counter = getCounter(d[idx+2:])
 
 	return p
 }
+
+// Marshal will serialise the frame content for writing
+func (p *POPM) Marshal() []byte {
+	b := PutTermStr(p.Email, EncodingLatin1)
+	b = append(b, byte(p.Popularity))
+
+	return append(b, putCounter(p.Counter)...)
+}
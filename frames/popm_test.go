@@ -37,3 +37,7 @@ func TestPopmProcess(t *testing.T) {
 		t.Fatalf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func FuzzPopmProcess(f *testing.F) {
+	fuzzFrame(f, "POPM", "bob@example.com\x00\xff\x00\x00\x00\x10")
+}
@@ -22,6 +22,11 @@ func (p *POSS) ProcessData(s int, d []byte) IFrame {
 	p.Size = s
 	p.Data = d
 
+	if len(d) < 1 {
+		p.fail(ErrTruncated, "has no content")
+		return p
+	}
+
 	format := GetSize([]byte{d[0]}, 1)
 	if format == 1 {
 		p.Format = "MPEG"
@@ -33,3 +38,13 @@ func (p *POSS) ProcessData(s int, d []byte) IFrame {
 
 	return p
 }
+
+// Marshal will serialise the frame content for writing
+func (p *POSS) Marshal() []byte {
+	b := []byte{'\x02'}
+	if p.Format == "MPEG" {
+		b[0] = '\x01'
+	}
+
+	return append(b, p.Position...)
+}
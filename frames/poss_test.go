@@ -46,3 +46,7 @@ func TestPossProcess(t *testing.T) {
 		t.Fatalf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func FuzzPossProcess(f *testing.F) {
+	fuzzFrame(f, "POSS", "\x02\x00\x00\x10\x00")
+}
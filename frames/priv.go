@@ -1,9 +1,6 @@
 package frames
 
-import (
-	"bytes"
-	"fmt"
-)
+import "fmt"
 
 // PRIV provides a private frame
 type PRIV struct {
@@ -23,9 +20,12 @@ func (p *PRIV) ProcessData(s int, d []byte) IFrame {
 	p.Size = s
 	p.Data = d
 
-	idx := bytes.IndexByte(d, '\x00')
-	p.Owner = GetStr(d[:idx])
-	p.PrivateData = d[idx+1:]
+	p.Owner, p.PrivateData = GetTermStr(EncodingLatin1, d)
 
 	return p
 }
+
+// Marshal will serialise the frame content for writing
+func (p *PRIV) Marshal() []byte {
+	return append(PutTermStr(p.Owner, EncodingLatin1), p.PrivateData...)
+}
@@ -46,3 +46,7 @@ func TestPrivProcess(t *testing.T) {
 		t.Fatalf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func FuzzPrivProcess(f *testing.F) {
+	fuzzFrame(f, "PRIV", "Bob\x005fff5")
+}
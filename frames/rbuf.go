@@ -24,9 +24,23 @@ func (r *RBUF) ProcessData(s int, d []byte) IFrame {
 	r.Size = s
 	r.Data = d
 
+	// 3 bytes of size, a flag byte, and an optional 4 byte offset
+	if len(d) < 4 {
+		r.fail(ErrTruncated, "requires at least 4 bytes, found [%d]", len(d))
+		return r
+	}
+
 	r.BufferSize = GetSize(d[:3], 8)
-	r.EmbeddedInfo = GetBoolBit(d[4], 1)
-	r.Offset = GetSize(d[5:], 8)
+	r.EmbeddedInfo = GetBoolBit(d[3], 0)
+	r.Offset = GetSize(d[4:], 8)
 
 	return r
 }
+
+// Marshal will serialise the frame content for writing
+func (r *RBUF) Marshal() []byte {
+	b := PutSize(r.BufferSize, 3, 8)
+	b = append(b, PutBool(0, 0, r.EmbeddedInfo))
+
+	return append(b, PutSize(r.Offset, 4, 8)...)
+}
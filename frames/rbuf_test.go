@@ -50,3 +50,7 @@ func TestRbufVersion2(t *testing.T) {
 
 	x.ProcessData(len(b), b)
 }
+
+func FuzzRbufProcess(f *testing.F) {
+	fuzzFrame(f, "RBUF", "\x00\x10\x00\x01\x00\x00\x00\x10")
+}
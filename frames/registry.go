@@ -0,0 +1,94 @@
+package frames
+
+import (
+	"fmt"
+	"sync"
+)
+
+var (
+	registryLock sync.RWMutex
+	registered   = map[int]map[string]registration{}
+)
+
+// registration is a decoder given through Register, held apart from the built in
+// frame mappings so that they are only ever read
+type registration struct {
+	description string
+	factory     func() IFrame
+}
+
+// Register will provide a decoder for the frame identifier within a version,
+// replacing any decoder already known for it, including those built in. The factory
+// gives a fresh frame each time, which then has its name, description and version
+// set. Registering is safe alongside frames being created and tags being parsed.
+func Register(id string, version int, description string, factory func() IFrame) error {
+	if factory == nil {
+		return fmt.Errorf("no factory given for frame [%s]", id)
+	}
+
+	l := 4
+	if version == Version2 {
+		l = 3
+	}
+	if len(id) != l {
+		return fmt.Errorf("frame [%s] must be %d characters for v2.%d", id, l, version)
+	}
+
+	if versionFrames(version) == nil {
+		return fmt.Errorf("unsupported version [%d]", version)
+	}
+
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	if registered[version] == nil {
+		registered[version] = map[string]registration{}
+	}
+	registered[version][id] = registration{description: description, factory: factory}
+
+	return nil
+}
+
+// Lookup provides the generator for the frame identifier within a version, along
+// with whether one is known. Registered decoders are preferred over those built in.
+// It is safe alongside Register.
+func Lookup(id string, version int) (func() IFrame, bool) {
+	registryLock.RLock()
+	r, ok := registered[version][id]
+	registryLock.RUnlock()
+	if ok {
+		return Gen(id, r.description, version), true
+	}
+
+	g, ok := versionFrames(version)[id]
+
+	return g, ok
+}
+
+// versionFrames provides the frame mapping for the version
+func versionFrames(version int) map[string]func() IFrame {
+	switch version {
+	case Version2:
+		return Version22Frames
+	case Version3:
+		return Version23Frames
+	case Version4:
+		return Version24Frames
+	}
+
+	return nil
+}
+
+// factory provides the constructor for the frame, preferring any registered for the
+// version over those built in
+func factory(n string, version int) (func() IFrame, bool) {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	if r, ok := registered[version][n]; ok {
+		return r.factory, true
+	}
+	a, ok := frameInst[n]
+
+	return a, ok
+}
@@ -0,0 +1,99 @@
+package frames
+
+import (
+	"sync"
+	"testing"
+)
+
+type podcastFrame struct {
+	TEXT
+}
+
+func TestRegister(t *testing.T) {
+	err := Register("PCST", Version4, "Podcast", func() IFrame { return new(podcastFrame) })
+	if err != nil {
+		t.Fatalf("Got [%s], Expected no error", err)
+	}
+
+	g, ok := Lookup("PCST", Version4)
+	if !ok {
+		t.Fatalf("Expected [PCST] to be found")
+	}
+
+	x, ok := g().(*podcastFrame)
+	if !ok {
+		t.Fatalf("Got [%#v], Expected a podcast frame", g())
+	}
+	if x.GetName() != "PCST" || x.GetExplain() != "Podcast" || x.Version != Version4 {
+		t.Fatalf("Got [%s] [%s] [%d], Expected [PCST] [Podcast] [4]", x.GetName(), x.GetExplain(), x.Version)
+	}
+
+	if _, ok = Version24Frames["PCST"]; ok {
+		t.Fatalf("Expected [PCST] to be held apart from the built in frames")
+	}
+	if NewFrame("PCST", "", Version3) != nil {
+		t.Fatalf("Expected [PCST] to only be known for v2.4")
+	}
+	if _, ok = Lookup("PCST", Version3); ok {
+		t.Fatalf("Expected [PCST] to only be found for v2.4")
+	}
+}
+
+func TestRegisterOverride(t *testing.T) {
+	defer func() {
+		_ = Register("TPE4", Version3, "Interpreted, remixed, or otherwise modified by", func() IFrame { return new(TEXT) })
+	}()
+
+	if _, ok := NewFrame("TPE4", "", Version3).(*TEXT); !ok {
+		t.Fatalf("Expected the built in frame before overriding")
+	}
+
+	_ = Register("TPE4", Version3, "Remixer", func() IFrame { return new(podcastFrame) })
+	if _, ok := NewFrame("TPE4", "", Version3).(*podcastFrame); !ok {
+		t.Fatalf("Expected the registered frame to override the built in frame")
+	}
+	if _, ok := NewFrame("TPE4", "", Version4).(*TEXT); !ok {
+		t.Fatalf("Expected other versions to keep the built in frame")
+	}
+}
+
+func TestRegisterInvalid(t *testing.T) {
+	f := func() IFrame { return new(TEXT) }
+	cases := []struct {
+		id      string
+		version int
+		factory func() IFrame
+	}{
+		{"MVNM", Version4, nil},
+		{"MVN", Version4, f},
+		{"MVNM", Version2, f},
+		{"MVNM", 5, f},
+	}
+
+	for _, c := range cases {
+		if err := Register(c.id, c.version, "", c.factory); err == nil {
+			t.Fatalf("Expected an error registering [%s] for v2.%d", c.id, c.version)
+		}
+	}
+}
+
+func TestRegisterConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_ = Register("XCON", Version3, "Concurrent", func() IFrame { return new(TEXT) })
+		}()
+		go func() {
+			defer wg.Done()
+			_ = NewFrame("XCON", "", Version3)
+			_, _ = Lookup("TIT2", Version3)
+		}()
+	}
+	wg.Wait()
+
+	if NewFrame("XCON", "", Version3) == nil {
+		t.Fatalf("Expected [XCON] to be registered")
+	}
+}
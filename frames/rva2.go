@@ -1,9 +1,6 @@
 package frames
 
-import (
-	"bytes"
-	"fmt"
-)
+import "fmt"
 
 // RVA2 provides relative volume adjustment for the file
 type RVA2 struct {
@@ -16,6 +13,7 @@ type RVA2 struct {
 type channel struct {
 	Type       string  `json:"type"`
 	Adjustment float64 `json:"adjustment"`
+	Bits       int     `json:"bits"`
 	Volume     int     `json:"volume"`
 }
 
@@ -47,11 +45,14 @@ func (r *RVA2) ProcessData(s int, d []byte) IFrame {
 	r.Data = d
 	r.Channels = []*channel{}
 
-	idx := bytes.IndexByte(d, '\x00')
-	r.Identification = GetStr(d[:idx])
-	d = d[idx+1:]
+	r.Identification, d = GetTermStr(EncodingLatin1, d)
 
 	for len(d) > 0 {
+		if len(d) < 4 {
+			r.fail(ErrTruncated, "channel is missing its adjustment")
+			break
+		}
+
 		c := &channel{}
 		check := GetSize([]byte{d[0]}, 8)
 		c.Type = channelTypes[check]
@@ -64,6 +65,7 @@ func (r *RVA2) ProcessData(s int, d []byte) IFrame {
 		d = d[2:]
 
 		bits := GetSize([]byte{d[0]}, 8)
+		c.Bits = bits
 		d = d[1:]
 
 		bytes := int(float64(bits / 8))
@@ -82,3 +84,24 @@ func (r *RVA2) ProcessData(s int, d []byte) IFrame {
 
 	return r
 }
+
+// Marshal will serialise the frame content for writing
+func (r *RVA2) Marshal() []byte {
+	b := PutTermStr(r.Identification, EncodingLatin1)
+	for _, c := range r.Channels {
+		kind := 0
+		for k, v := range channelTypes {
+			if v == c.Type {
+				kind = k
+				break
+			}
+		}
+
+		b = append(b, byte(kind))
+		b = append(b, PutSize(int(c.Adjustment*512), 2, 8)...)
+		b = append(b, byte(c.Bits))
+		b = append(b, PutSize(c.Volume, c.Bits/8, 8)...)
+	}
+
+	return b
+}
@@ -50,3 +50,7 @@ func TestRva2Fixup(t *testing.T) {
 		t.Errorf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func FuzzRva2Process(f *testing.F) {
+	fuzzFrame(f, "RVA2", "id\x00\x01\x02\x00\x10\x124")
+}
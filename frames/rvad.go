@@ -65,17 +65,10 @@ func (r *RVAD) ProcessData(s int, d []byte) IFrame {
 	r.Data = d
 
 	if len(d) < 3 {
+		r.fail(ErrTruncated, "requires at least 3 bytes, found [%d]", len(d))
 		return r
 	}
 
-	// let's not just blindly check all these lengths...
-	defer func() IFrame {
-		// eat the panic
-		_ = recover()
-
-		return r
-	}()
-
 	r.IncrementRight = GetBoolBit(d[0], 7)
 	r.IncrementLeft = GetBoolBit(d[0], 6)
 	r.IncrementRightBack = GetBoolBit(d[0], 5)
@@ -87,40 +80,64 @@ func (r *RVAD) ProcessData(s int, d []byte) IFrame {
 	r.Bytes = int(math.Ceil(float64(GetSize([]byte{d[0]}, 8)) / 8))
 	d = d[1:]
 
-	r.RelativeRight = float64(GetSize(d[:r.Bytes], 8)) / float64(512)
-	d = d[r.Bytes:]
-
-	r.RelativeLeft = float64(GetSize(d[:r.Bytes], 8)) / float64(512)
-	d = d[r.Bytes:]
-
-	r.PeakRight = float64(GetSize(d[:r.Bytes], 8)) / float64(512)
-	d = d[r.Bytes:]
-
-	r.PeakLeft = float64(GetSize(d[:r.Bytes], 8)) / float64(512)
-	d = d[r.Bytes:]
-
-	r.RelativeRightBack = float64(GetSize(d[:r.Bytes], 8)) / float64(512)
-	d = d[r.Bytes:]
-
-	r.RelativeLeftBack = float64(GetSize(d[:r.Bytes], 8)) / float64(512)
-	d = d[r.Bytes:]
-
-	r.PeakRightBack = float64(GetSize(d[:r.Bytes], 8)) / float64(512)
-	d = d[r.Bytes:]
+	if r.Bytes < 1 {
+		r.fail(ErrInvalid, "has no bits used for volume description")
+		return r
+	}
 
-	r.PeakLeftBack = float64(GetSize(d[:r.Bytes], 8)) / float64(512)
-	d = d[r.Bytes:]
+	// only the front channels are required, those after may be left out entirely
+	values := []*float64{
+		&r.RelativeRight, &r.RelativeLeft, &r.PeakRight, &r.PeakLeft,
+		&r.RelativeRightBack, &r.RelativeLeftBack, &r.PeakRightBack, &r.PeakLeftBack,
+		&r.RelativeCenter, &r.PeakCenter,
+		&r.RelativeBass, &r.PeakBass,
+	}
+	for i, v := range values {
+		if len(d) < r.Bytes {
+			if i < 4 {
+				r.fail(ErrTruncated, "front channel values are missing")
+			}
+			break
+		}
+
+		*v = float64(GetSize(d[:r.Bytes], 8)) / float64(512)
+		d = d[r.Bytes:]
+	}
 
-	r.RelativeCenter = float64(GetSize(d[:r.Bytes], 8)) / float64(512)
-	d = d[r.Bytes:]
+	return r
+}
 
-	r.PeakCenter = float64(GetSize(d[:r.Bytes], 8)) / float64(512)
-	d = d[r.Bytes:]
+// Marshal will serialise the frame content for writing
+func (r *RVAD) Marshal() []byte {
+	var inc byte
+	inc = PutBool(inc, 7, r.IncrementRight)
+	inc = PutBool(inc, 6, r.IncrementLeft)
+	inc = PutBool(inc, 5, r.IncrementRightBack)
+	inc = PutBool(inc, 4, r.IncrementLeftBack)
+	inc = PutBool(inc, 3, r.IncrementCenter)
+	inc = PutBool(inc, 2, r.IncrementBass)
+
+	values := []float64{r.RelativeRight, r.RelativeLeft, r.PeakRight, r.PeakLeft}
+	if r.RelativeRightBack != 0 || r.RelativeLeftBack != 0 || r.PeakRightBack != 0 || r.PeakLeftBack != 0 ||
+		r.RelativeCenter != 0 || r.PeakCenter != 0 || r.RelativeBass != 0 || r.PeakBass != 0 {
+		values = append(values, r.RelativeRightBack, r.RelativeLeftBack, r.PeakRightBack, r.PeakLeftBack)
+	}
+	if r.RelativeCenter != 0 || r.PeakCenter != 0 || r.RelativeBass != 0 || r.PeakBass != 0 {
+		values = append(values, r.RelativeCenter, r.PeakCenter)
+	}
+	if r.RelativeBass != 0 || r.PeakBass != 0 {
+		values = append(values, r.RelativeBass, r.PeakBass)
+	}
 
-	r.RelativeBass = float64(GetSize(d[:r.Bytes], 8)) / float64(512)
-	d = d[r.Bytes:]
+	bits := r.Bytes
+	if bits < 1 {
+		bits = 2
+	}
 
-	r.PeakBass = float64(GetSize(d[:r.Bytes], 8)) / float64(512)
+	b := []byte{inc, byte(bits * 8)}
+	for _, v := range values {
+		b = append(b, PutSize(int(v*512), bits, 8)...)
+	}
 
-	return r
+	return b
 }
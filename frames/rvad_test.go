@@ -71,3 +71,7 @@ func TestRvadFullProcess(t *testing.T) {
 		t.Fatalf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func FuzzRvadProcess(f *testing.F) {
+	fuzzFrame(f, "RVAD", "\x03\x08\x02\x04\x06\x08")
+}
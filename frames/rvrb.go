@@ -1,9 +1,6 @@
 package frames
 
-import (
-	"fmt"
-	"os"
-)
+import "fmt"
 
 // RVRB contains equalisation settings for the file
 type RVRB struct {
@@ -46,7 +43,7 @@ func (r *RVRB) ProcessData(s int, d []byte) IFrame {
 	r.Data = d
 
 	if len(d) != 12 {
-		fmt.Fprintf(os.Stderr, "Invalid RVRB frame content length")
+		r.fail(ErrInvalid, "requires 12 bytes, found [%d]", len(d))
 	} else {
 		r.ReverbLeft = GetSize(d[:2], 8)
 		r.ReverbRight = GetSize(d[2:4], 8)
@@ -62,3 +59,16 @@ func (r *RVRB) ProcessData(s int, d []byte) IFrame {
 
 	return r
 }
+
+// Marshal will serialise the frame content for writing
+func (r *RVRB) Marshal() []byte {
+	b := PutSize(r.ReverbLeft, 2, 8)
+	b = append(b, PutSize(r.ReverbRight, 2, 8)...)
+	b = append(b, byte(r.BouncesLeft), byte(r.BouncesRight))
+
+	for _, v := range []int{r.FeedbackLtol, r.FeedbackLtor, r.FeedbackRtor, r.FeedbackRtol, r.PremixLtor, r.PremixRtol} {
+		b = append(b, PutBytePercent(v))
+	}
+
+	return b
+}
@@ -53,3 +53,7 @@ func TestRvrbFail(t *testing.T) {
 		t.Fatalf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func FuzzRvrbProcess(f *testing.F) {
+	fuzzFrame(f, "RVRB", "\x00\x10\x00 \x01\x02\x03\x04\x05\x06\x07\x08")
+}
@@ -1,9 +1,6 @@
 package frames
 
-import (
-	"fmt"
-	"os"
-)
+import "fmt"
 
 // SEEK provides Seek details for the file
 type SEEK struct {
@@ -23,7 +20,7 @@ func (e *SEEK) ProcessData(s int, d []byte) IFrame {
 	e.Data = d
 
 	if len(e.Data) < 4 {
-		fmt.Fprintf(os.Stderr, "SEEK frame invalid length [%d<4]\n", len(e.Data))
+		e.fail(ErrTruncated, "requires 4 bytes, found [%d]", len(e.Data))
 
 		return e
 	}
@@ -32,3 +29,8 @@ func (e *SEEK) ProcessData(s int, d []byte) IFrame {
 
 	return e
 }
+
+// Marshal will serialise the frame content for writing
+func (e *SEEK) Marshal() []byte {
+	return PutSize(e.SeekPoint, 4, 8)
+}
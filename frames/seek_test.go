@@ -48,3 +48,7 @@ func TestSeekFail(t *testing.T) {
 		t.Errorf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func FuzzSeekProcess(f *testing.F) {
+	fuzzFrame(f, "SEEK", "\x00\x00\x00\x10")
+}
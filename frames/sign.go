@@ -20,8 +20,18 @@ func (i *SIGN) ProcessData(s int, d []byte) IFrame {
 	i.Size = s
 	i.Data = d
 
+	if len(d) < 1 {
+		i.fail(ErrTruncated, "group symbol is missing")
+		return i
+	}
+
 	i.Symbol = d[0]
 	i.Signature = d[1:]
 
 	return i
 }
+
+// Marshal will serialise the frame content for writing
+func (i *SIGN) Marshal() []byte {
+	return append([]byte{i.Symbol}, i.Signature...)
+}
@@ -48,3 +48,7 @@ func TestSignFail(t *testing.T) {
 		t.Errorf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func FuzzSignProcess(f *testing.F) {
+	fuzzFrame(f, "SIGN", "\x80signature")
+}
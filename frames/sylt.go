@@ -1,9 +1,6 @@
 package frames
 
-import (
-	"bytes"
-	"fmt"
-)
+import "fmt"
 
 // SYLT defines the Synchronised lyrics/text
 type SYLT struct {
@@ -54,12 +51,14 @@ func (y *SYLT) ProcessData(s int, d []byte) IFrame {
 	y.Data = d
 	y.Items = []*txtItem{}
 
-	x := d[0]
-	if x == '\x01' {
-		y.Utf16 = true
+	if len(d) < 6 {
+		y.fail(ErrTruncated, "requires at least 6 bytes, found [%d]", len(d))
+		return y
 	}
+
+	y.Encoding = GetEncoding(d[0])
 	d = d[1:]
-	y.Language = GetStr(d[0:3])
+	y.Language = GetLatin1(d[0:3])
 	d = d[3:]
 
 	form := GetSize([]byte{d[0]}, 1)
@@ -75,30 +74,53 @@ func (y *SYLT) ProcessData(s int, d []byte) IFrame {
 	y.ContentType = types[ct]
 	d = d[2:]
 
-	if !y.Utf16 {
-		idx := bytes.IndexByte(d, '\x00')
-		y.Descriptor = GetStr(d[:idx])
-		d = d[idx+1:]
-	} else {
-		idx := bytes.Index(d, []byte{'\x00', '\x00'})
-		y.Descriptor = GetUnicodeStr(d[:idx])
-		d = d[idx+2:]
-	}
+	y.Descriptor, d = GetTermStr(y.Encoding, d)
 
 	for {
-		idx := bytes.IndexByte(d, '\x00')
-		t := &txtItem{Content: GetStr(d[:idx])}
-		d = d[idx+1:]
+		t := &txtItem{}
+		t.Content, d = GetTermStr(y.Encoding, d)
+		if len(d) < 4 {
+			y.fail(ErrTruncated, "time stamp is missing")
+			break
+		}
 
-		t.Timestamp = GetSize(d[:2], 8)
-		d = d[2:]
+		t.Timestamp = GetSize(d[:4], 8)
+		d = d[4:]
 
 		y.Items = append(y.Items, t)
 
-		if len(d) < 3 || bytes.IndexByte(d, '\x00') < 2 {
+		if len(d) < 3 || GetTerm(y.Encoding, d) < 1 {
 			break
 		}
 	}
 
 	return y
 }
+
+// Marshal will serialise the frame content for writing
+func (y *SYLT) Marshal() []byte {
+	ct := 0
+	for k, v := range types {
+		if v == y.ContentType {
+			ct = k
+			break
+		}
+	}
+
+	b := []byte{y.Encoding}
+	b = append(b, putFixed(y.Language, 3)...)
+	if y.Format == "mpeg" {
+		b = append(b, '\x01')
+	} else {
+		b = append(b, '\x02')
+	}
+	b = append(b, byte(ct))
+	b = append(b, PutTermStr(y.Descriptor, y.Encoding)...)
+
+	for _, v := range y.Items {
+		b = append(b, PutTermStr(v.Content, y.Encoding)...)
+		b = append(b, PutSize(v.Timestamp, 4, 8)...)
+	}
+
+	return b
+}
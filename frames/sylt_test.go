@@ -28,13 +28,13 @@ func TestSyltBasic(t *testing.T) {
 func TestSyltProcess(t *testing.T) {
 	x := NewFrame("SYLT", "", Version3).(*SYLT)
 	b := []byte("\x00eng\x02\x01Lyrics\x00" +
-		"Bob\x00\x00\x35" +
-		"Down\x00\x01\x56")
+		"Bob\x00\x00\x00\x00\x35" +
+		"Down\x00\x00\x01\x00\x00")
 
 	x.ProcessData(len(b), b)
 
 	expected := "Synchronised (Lyrics). Language(eng) Format(ms) Content Type(Lyrics)\n" +
-		"\tBob [53]\n\tDown [342]\n"
+		"\tBob [53]\n\tDown [65536]\n"
 	found := x.DisplayContent()
 	if found != expected {
 		t.Fatalf("Got [%s], Expected [%s]", found, expected)
@@ -44,8 +44,8 @@ func TestSyltProcess(t *testing.T) {
 func TestSyltUtf16(t *testing.T) {
 	x := NewFrame("SYLT", "", Version4).(*SYLT)
 	b := []byte("\x01eng\x01\x08\xfe\xffDerp\x00\x00" +
-		"XYZ\x00\x03\x44" +
-		"ABC\x00\x00\x20")
+		"\xfe\xff\x00X\x00Y\x00Z\x00\x00\x00\x00\x03\x44" +
+		"\xfe\xff\x00A\x00B\x00C\x00\x00\x00\x00\x00\x20")
 
 	x.ProcessData(len(b), b)
 
@@ -56,3 +56,7 @@ func TestSyltUtf16(t *testing.T) {
 		t.Fatalf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func FuzzSyltProcess(f *testing.F) {
+	fuzzFrame(f, "SYLT", "\x00eng\x02\x01desc\x00line\x00\x00\x00\x00\x10")
+}
@@ -30,6 +30,11 @@ func (z *SYTC) ProcessData(s int, d []byte) IFrame {
 	z.Size = s
 	z.Data = d
 
+	if len(d) < 1 {
+		z.fail(ErrTruncated, "has no content")
+		return z
+	}
+
 	f := d[0]
 	z.Format = "ms"
 	if f == '\x01' {
@@ -40,7 +45,7 @@ func (z *SYTC) ProcessData(s int, d []byte) IFrame {
 	for len(d) >= 4 {
 		x := &tempo{BeatsPerMinute: 0}
 		b := d[0]
-		if b == '\xff' {
+		if b == '\xff' && len(d) >= 5 {
 			x.BeatsPerMinute = GetSize([]byte{d[0]}, 8)
 			d = d[1:]
 			b = d[0]
@@ -56,3 +61,23 @@ func (z *SYTC) ProcessData(s int, d []byte) IFrame {
 
 	return z
 }
+
+// Marshal will serialise the frame content for writing
+func (z *SYTC) Marshal() []byte {
+	b := []byte{'\x02'}
+	if z.Format == "mpeg" {
+		b[0] = '\x01'
+	}
+
+	for _, v := range z.TempoData {
+		bpm := v.BeatsPerMinute
+		if bpm >= 255 {
+			b = append(b, '\xff')
+			bpm -= 255
+		}
+		b = append(b, byte(bpm))
+		b = append(b, PutSize(v.TimeCode, 3, 8)...)
+	}
+
+	return b
+}
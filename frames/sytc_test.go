@@ -41,3 +41,7 @@ func TestSytcProcess(t *testing.T) {
 		t.Fatalf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func FuzzSytcProcess(f *testing.F) {
+	fuzzFrame(f, "SYTC", "\x02x\x00\x00\x10\xff\x01\x00\x00 ")
+}
@@ -1,12 +1,20 @@
 package frames
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // TEXT houses anything just for a TEXT frame
 type TEXT struct {
 	Frame
+
+	Values []string `json:"values"`
 }
 
+// TextSeparator is used when joining multiple values for display within Cleaned
+const TextSeparator = " / "
+
 // DisplayContent will comprehensively display known information
 func (t *TEXT) DisplayContent() string {
 	return fmt.Sprintf("[%s - %d] (%s) %s\n", t.Name, t.Size, t.Description, t.Cleaned)
@@ -38,19 +46,51 @@ func (t *TEXT) ProcessData(s int, d []byte) IFrame {
 	t.Size = s
 	t.Data = d
 
-	// text encoding is a single byte, 0 for latin, 1 for unicode
+	// text encoding is a single byte, with v2.4 allowing null separated values
+	t.Values = []string{}
 	if len(d) > 1 {
-		if d[0] == '\x01' {
-			t.Utf16 = true
-		}
-		d = d[1:]
+		t.Encoding = GetEncoding(d[0])
+		t.Values = GetEncStrs(t.Encoding, d[1:])
+	}
+	t.Cleaned = strings.Join(t.Values, TextSeparator)
+
+	return t
+}
+
+// GetValues provides each of the values within the frame. When Cleaned has been
+// changed directly, it is taken as the only value.
+func (t *TEXT) GetValues() []string {
+	if strings.Join(t.Values, TextSeparator) == t.Cleaned {
+		return t.Values
+	}
 
-		if !t.Utf16 {
-			t.Cleaned = GetStr(d)
-		} else {
-			t.Cleaned = GetUnicodeStr(d)
+	if len(t.Cleaned) < 1 {
+		return []string{}
+	}
+
+	return []string{t.Cleaned}
+}
+
+// SetValues will replace the content of the frame with the values
+func (t *TEXT) SetValues(v ...string) {
+	t.Values = v
+	t.Cleaned = strings.Join(v, TextSeparator)
+}
+
+// Marshal will serialise the frame content for writing. Only v2.4 separates values
+// with nulls, so earlier versions have them joined with a slash.
+func (t *TEXT) Marshal() []byte {
+	b := []byte{t.Encoding}
+	if t.Version < Version4 {
+		return append(b, PutStr(strings.Join(t.GetValues(), "/"), t.Encoding)...)
+	}
+
+	for k, v := range t.GetValues() {
+		if k > 0 {
+			b = append(b, make([]byte, GetTermLength(t.Encoding))...)
 		}
+		b = append(b, PutStr(v, t.Encoding)...)
 	}
 
-	return t
+	return b
 }
@@ -1,6 +1,9 @@
 package frames
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestTextBasicOutput(t *testing.T) {
 	x := NewFrame("TEXT", "Lyricist/Text writer", Version3).(*TEXT)
@@ -58,3 +61,99 @@ func TestTextUtf16(t *testing.T) {
 		t.Errorf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func TestTextParseLatin1(t *testing.T) {
+	x := NewFrame("TPE1", "", Version3).(*TEXT)
+	b := []byte("\x00Bj\xf6rk")
+
+	x.ProcessData(len(b), b)
+	expected := "Björk"
+	if x.Cleaned != expected {
+		t.Errorf("Got [%s], Expected [%s]", x.Cleaned, expected)
+	}
+}
+
+func TestTextParseUtf8(t *testing.T) {
+	x := NewFrame("TPE1", "", Version4).(*TEXT)
+	b := []byte("\x03Sigur R\xc3\xb3s")
+
+	x.ProcessData(len(b), b)
+	if x.Cleaned != "Sigur Rós" || x.Encoding != EncodingUTF8 {
+		t.Errorf("Got [%s] (%d), Expected [Sigur Rós] (3)", x.Cleaned, x.Encoding)
+	}
+}
+
+func TestTextParseUtf16BE(t *testing.T) {
+	x := NewFrame("TPE1", "", Version4).(*TEXT)
+	b := []byte("\x02\x00S\x00i\x00g\x00u\x00r\x00 \x00R\x00\xf3\x00s")
+
+	x.ProcessData(len(b), b)
+	if x.Cleaned != "Sigur Rós" || x.Encoding != EncodingUTF16BE {
+		t.Errorf("Got [%s] (%d), Expected [Sigur Rós] (2)", x.Cleaned, x.Encoding)
+	}
+}
+
+func TestTextMultipleValues(t *testing.T) {
+	cases := map[string][]string{
+		"\x00Bob\x00Jim\x00": {"Bob", "Jim"},
+		"\x01\xff\xfeB\x00o\x00b\x00\x00\x00\xff\xfeJ\x00i\x00m\x00": {"Bob", "Jim"},
+		"\x01\xff\xfeB\x00o\x00b\x00\x00\x00J\x00i\x00m\x00":         {"Bob", "Jim"},
+		"\x02\x00B\x00o\x00b\x00\x00\x00J\x00i\x00m":                 {"Bob", "Jim"},
+		"\x03B\xc3\xb6b\x00J\xc3\xafm":                               {"Böb", "Jïm"},
+	}
+
+	for in, expected := range cases {
+		x := NewFrame("TPE1", "", Version4).(*TEXT)
+		b := []byte(in)
+		x.ProcessData(len(b), b)
+
+		if strings.Join(x.Values, "|") != strings.Join(expected, "|") {
+			t.Errorf("Got [%#v], Expected [%#v]", x.Values, expected)
+		}
+		if x.Cleaned != strings.Join(expected, TextSeparator) {
+			t.Errorf("Got [%s], Expected [%s]", x.Cleaned, strings.Join(expected, TextSeparator))
+		}
+	}
+}
+
+func TestTextMarshalValues(t *testing.T) {
+	x := NewFrame("TPE1", "", Version4).(*TEXT)
+	x.SetValues("Bob", "Jim")
+
+	expected := "\x00Bob\x00Jim"
+	if found := string(x.Marshal()); found != expected {
+		t.Errorf("Got [%#v], Expected [%#v]", found, expected)
+	}
+
+	x.Encoding = EncodingUTF16
+	expected = "\x01\xfe\xff\x00B\x00o\x00b\x00\x00\xfe\xff\x00J\x00i\x00m"
+	if found := string(x.Marshal()); found != expected {
+		t.Errorf("Got [%#v], Expected [%#v]", found, expected)
+	}
+
+	// a direct change to Cleaned takes over from the values
+	x.Encoding = EncodingLatin1
+	x.Cleaned = "Bob and Jim"
+	expected = "\x00Bob and Jim"
+	if found := string(x.Marshal()); found != expected {
+		t.Errorf("Got [%#v], Expected [%#v]", found, expected)
+	}
+
+	// v2.3 has no null separated values
+	y := NewFrame("TPE1", "", Version3).(*TEXT)
+	y.SetValues("Bob", "Jim")
+	expected = "\x00Bob/Jim"
+	if found := string(y.Marshal()); found != expected {
+		t.Errorf("Got [%#v], Expected [%#v]", found, expected)
+	}
+
+	y.Encoding = EncodingUTF16
+	expected = "\x01\xfe\xff\x00B\x00o\x00b\x00/\x00J\x00i\x00m"
+	if found := string(y.Marshal()); found != expected {
+		t.Errorf("Got [%#v], Expected [%#v]", found, expected)
+	}
+}
+
+func FuzzTextProcess(f *testing.F) {
+	fuzzFrame(f, "TIT2", "\x00title", "\x01\xff\xfet\x00\x00\x00\xff\xfeu\x00")
+}
@@ -1,9 +1,6 @@
 package frames
 
-import (
-	"bytes"
-	"fmt"
-)
+import "fmt"
 
 // TXXX provides the user string from the file
 type TXXX struct {
@@ -23,25 +20,20 @@ func (t *TXXX) ProcessData(s int, d []byte) IFrame {
 	t.Size = s
 	t.Data = d
 
-	// text encoding is a single byte, 0 for latin, 1 for unicode
+	// text encoding is a single byte
 	if len(d) > 2 {
-		enc := d[0]
-		d = d[1:]
-
-		switch enc {
-		case '\x00':
-			idx := bytes.IndexByte(d, '\x00')
-			t.Type = GetStr(d[:idx])
-			t.Value = GetStr(d[idx+LengthStandard:])
-
-		case '\x01':
-			t.Utf16 = true
-
-			idx := bytes.Index(d, []byte{'\x00', '\x00'})
-			t.Type = GetUnicodeStr(d[:idx])
-			t.Value = GetUnicodeStr(d[idx+LengthUnicode:])
-		}
+		t.Encoding = GetEncoding(d[0])
+		t.Type, d = GetTermStr(t.Encoding, d[1:])
+		t.Value = GetEncStr(t.Encoding, d)
 	}
 
 	return t
 }
+
+// Marshal will serialise the frame content for writing
+func (t *TXXX) Marshal() []byte {
+	b := []byte{t.Encoding}
+	b = append(b, PutTermStr(t.Type, t.Encoding)...)
+
+	return append(b, PutStr(t.Value, t.Encoding)...)
+}
@@ -48,3 +48,17 @@ func TestTxxxParseUtf16(t *testing.T) {
 		t.Errorf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func TestTxxxLatin1(t *testing.T) {
+	x := NewFrame("TXXX", "", Version3).(*TXXX)
+	b := []byte("\x00Stra\xdfe\x00M\xfcnchen")
+
+	x.ProcessData(len(b), b)
+	if x.Type != "Straße" || x.Value != "München" {
+		t.Fatalf("Got [%s] [%s], Expected [Straße] [München]", x.Type, x.Value)
+	}
+}
+
+func FuzzTxxxProcess(f *testing.F) {
+	fuzzFrame(f, "TXXX", "\x00desc\x00value")
+}
@@ -1,9 +1,6 @@
 package frames
 
-import (
-	"bytes"
-	"fmt"
-)
+import "fmt"
 
 // UFID provides descriptor of uniqueness for the file
 type UFID struct {
@@ -24,10 +21,13 @@ func (u *UFID) ProcessData(s int, d []byte) IFrame {
 	u.Data = d
 
 	if len(d) > 2 {
-		idx := bytes.IndexByte(d, '\x00')
-		u.Owner = GetStr(d[:idx])
-		u.Identifier = d[idx+1:]
+		u.Owner, u.Identifier = GetTermStr(EncodingLatin1, d)
 	}
 
 	return u
 }
+
+// Marshal will serialise the frame content for writing
+func (u *UFID) Marshal() []byte {
+	return append(PutTermStr(u.Owner, EncodingLatin1), u.Identifier...)
+}
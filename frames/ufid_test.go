@@ -36,3 +36,7 @@ func TestUfidParse(t *testing.T) {
 		t.Errorf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func FuzzUfidProcess(f *testing.F) {
+	fuzzFrame(f, "UFID", "http://x\x00identifier")
+}
@@ -0,0 +1,45 @@
+package frames
+
+import "fmt"
+
+// UNKNOWN holds a frame without a decoder, keeping the content exactly as it was
+// found within the tag so that it can be written back untouched. Any compression,
+// encryption or unsynchronisation given by the flags is left in place.
+type UNKNOWN struct {
+	Frame
+
+	Raw []byte `json:"raw" yaml:"raw"`
+}
+
+// NewUnknown provides a frame for an identifier without a decoder
+func NewUnknown(n string, v int) *UNKNOWN {
+	u := &UNKNOWN{}
+	u.Init(n, "Unknown frame", v)
+
+	return u
+}
+
+// DisplayContent will comprehensively display known information
+func (u *UNKNOWN) DisplayContent() string {
+	return fmt.Sprintf("Unknown frame [%s]\nData: %#x\n", u.Name, u.Raw)
+}
+
+// ProcessData will hold on to the content as is
+func (u *UNKNOWN) ProcessData(s int, d []byte) IFrame {
+	u.Size = s
+	u.Data = d
+	u.Raw = d
+
+	return u
+}
+
+// Marshal provides the content as it was found
+func (u *UNKNOWN) Marshal() []byte {
+	return u.Raw
+}
+
+// Transformed determines if the content is held in a form that relies upon the
+// frame flags, such as being compressed, and so can't be moved to another version
+func (u *UNKNOWN) Transformed() bool {
+	return u.Compression || u.Encryption || u.Grouping || u.Unsynchronisation || u.DataLengthIndicator
+}
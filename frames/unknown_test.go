@@ -0,0 +1,31 @@
+package frames
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestUnknownProcess(t *testing.T) {
+	u := NewUnknown("GRP1", Version3)
+	b := []byte("\x00Grouping")
+	u.ProcessData(len(b), b)
+
+	expected := "Unknown frame [GRP1]\nData: 0x0047726f7570696e67\n"
+	found := u.DisplayContent()
+	if found != expected {
+		t.Fatalf("Got [%s], Expected [%s]", found, expected)
+	}
+
+	if !bytes.Equal(u.Marshal(), b) {
+		t.Fatalf("Got [%#v], Expected [%#v]", u.Marshal(), b)
+	}
+
+	if u.Transformed() {
+		t.Fatalf("Expected plain content to not be transformed")
+	}
+
+	u.ProcessFlags(Version3, []byte{0x00, 0x80})
+	if !u.Transformed() {
+		t.Fatalf("Expected compressed content to be transformed")
+	}
+}
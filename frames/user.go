@@ -20,17 +20,23 @@ func (u *USER) ProcessData(s int, d []byte) IFrame {
 	u.Size = s
 	u.Data = d
 
-	if d[0] == '\x01' {
-		u.Utf16 = true
+	if len(d) < 4 {
+		u.fail(ErrTruncated, "requires at least 4 bytes, found [%d]", len(d))
+		return u
 	}
-	d = d[1:]
-	u.Language = GetStr(d[:3])
 
-	if !u.Utf16 {
-		u.Text = GetStr(d[3:])
-	} else {
-		u.Text = GetUnicodeStr(d[3:])
-	}
+	u.Encoding = GetEncoding(d[0])
+	d = d[1:]
+	u.Language = GetLatin1(d[:3])
+	u.Text = GetEncStr(u.Encoding, d[3:])
 
 	return u
 }
+
+// Marshal will serialise the frame content for writing
+func (u *USER) Marshal() []byte {
+	b := []byte{u.Encoding}
+	b = append(b, putFixed(u.Language, 3)...)
+
+	return append(b, PutStr(u.Text, u.Encoding)...)
+}
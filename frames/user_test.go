@@ -48,3 +48,7 @@ func TestUserParseUtf16(t *testing.T) {
 		t.Errorf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func FuzzUserProcess(f *testing.F) {
+	fuzzFrame(f, "USER", "\x00engterms")
+}
@@ -1,9 +1,6 @@
 package frames
 
-import (
-	"bytes"
-	"fmt"
-)
+import "fmt"
 
 // USLT defines the Unsynchronised lyrics/text transcription
 type USLT struct {
@@ -28,23 +25,28 @@ func (u *USLT) ProcessData(s int, d []byte) IFrame {
 	u.Size = s
 	u.Data = d
 
-	if d[0] == '\x01' {
-		u.Utf16 = true
+	if len(d) < 4 {
+		u.fail(ErrTruncated, "requires at least 4 bytes, found [%d]", len(d))
+		return u
 	}
+
+	u.Encoding = GetEncoding(d[0])
 	d = d[1:]
 
-	u.Language = GetStr(d[:3])
+	u.Language = GetLatin1(d[:3])
 	d = d[3:]
 
-	if !u.Utf16 {
-		idx := bytes.IndexByte(d, '\x00')
-		u.Descriptor = GetStr(d[:idx])
-		u.Lyrics = GetStr(d[idx+1:])
-	} else {
-		idx := bytes.Index(d, []byte{'\x00', '\x00'})
-		u.Descriptor = GetUnicodeStr(d[:idx])
-		u.Lyrics = GetUnicodeStr(d[idx+2:])
-	}
+	u.Descriptor, d = GetTermStr(u.Encoding, d)
+	u.Lyrics = GetEncStr(u.Encoding, d)
 
 	return u
 }
+
+// Marshal will serialise the frame content for writing
+func (u *USLT) Marshal() []byte {
+	b := []byte{u.Encoding}
+	b = append(b, putFixed(u.Language, 3)...)
+	b = append(b, PutTermStr(u.Descriptor, u.Encoding)...)
+
+	return append(b, PutStr(u.Lyrics, u.Encoding)...)
+}
@@ -48,3 +48,17 @@ func TestUsltParseUtf16(t *testing.T) {
 		t.Errorf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func TestUsltLatin1(t *testing.T) {
+	x := NewFrame("USLT", "", Version3).(*USLT)
+	b := []byte("\x00fraChanson\x00\xc7a plane pour moi")
+
+	x.ProcessData(len(b), b)
+	if x.Descriptor != "Chanson" || x.Lyrics != "Ça plane pour moi" {
+		t.Fatalf("Got [%s] [%s], Expected [Chanson] [Ça plane pour moi]", x.Descriptor, x.Lyrics)
+	}
+}
+
+func FuzzUsltProcess(f *testing.F) {
+	fuzzFrame(f, "USLT", "\x00engdesc\x00lyrics")
+}
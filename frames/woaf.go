@@ -18,7 +18,12 @@ func (w *WOAF) DisplayContent() string {
 func (w *WOAF) ProcessData(s int, d []byte) IFrame {
 	w.Size = s
 	w.Data = d
-	w.URL = GetStr(d)
+	w.URL = GetLatin1(d)
 
 	return w
 }
+
+// Marshal will serialise the frame content for writing
+func (w *WOAF) Marshal() []byte {
+	return PutStr(w.URL, EncodingLatin1)
+}
@@ -36,3 +36,7 @@ func TestWoafParse(t *testing.T) {
 		t.Errorf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func FuzzWoafProcess(f *testing.F) {
+	fuzzFrame(f, "WOAF", "http://example.com")
+}
@@ -1,9 +1,6 @@
 package frames
 
-import (
-	"bytes"
-	"fmt"
-)
+import "fmt"
 
 // WXXX provides user defined webpage links
 type WXXX struct {
@@ -23,23 +20,20 @@ func (w *WXXX) ProcessData(s int, d []byte) IFrame {
 	w.Size = s
 	w.Data = d
 
-	// text encoding is a single byte, 0 for latin, 1 for unicode
+	// text encoding is a single byte, the url is always latin
 	if len(d) > 2 {
-		if d[0] == '\x01' {
-			w.Utf16 = true
-		}
-		d = d[1:]
-
-		if !w.Utf16 {
-			idx := bytes.IndexByte(d, '\x00')
-			w.Title = GetStr(d[:idx])
-			w.URL = GetStr(d[idx+LengthStandard:])
-		} else {
-			idx := bytes.Index(d, []byte{'\x00', '\x00'})
-			w.Title = GetUnicodeStr(d[:idx])
-			w.URL = GetStr(d[idx+LengthUnicode:])
-		}
+		w.Encoding = GetEncoding(d[0])
+		w.Title, d = GetTermStr(w.Encoding, d[1:])
+		w.URL = GetLatin1(d)
 	}
 
 	return w
 }
+
+// Marshal will serialise the frame content for writing
+func (w *WXXX) Marshal() []byte {
+	b := []byte{w.Encoding}
+	b = append(b, PutTermStr(w.Title, w.Encoding)...)
+
+	return append(b, PutStr(w.URL, EncodingLatin1)...)
+}
@@ -49,3 +49,7 @@ func TestWxxxParseUtf16(t *testing.T) {
 		t.Errorf("Got [%s], Expected [%s]", found, expected)
 	}
 }
+
+func FuzzWxxxProcess(f *testing.F) {
+	fuzzFrame(f, "WXXX", "\x00desc\x00http://example.com")
+}
@@ -0,0 +1,118 @@
+package id3
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Genres lists the ID3v1 genres by index, including the Winamp extensions
+var Genres = []string{
+	"Blues", "Classic Rock", "Country", "Dance", "Disco", "Funk", "Grunge", "Hip-Hop",
+	"Jazz", "Metal", "New Age", "Oldies", "Other", "Pop", "R&B", "Rap",
+	"Reggae", "Rock", "Techno", "Industrial", "Alternative", "Ska", "Death Metal", "Pranks",
+	"Soundtrack", "Euro-Techno", "Ambient", "Trip-Hop", "Vocal", "Jazz+Funk", "Fusion", "Trance",
+	"Classical", "Instrumental", "Acid", "House", "Game", "Sound Clip", "Gospel", "Noise",
+	"AlternRock", "Bass", "Soul", "Punk", "Space", "Meditative", "Instrumental Pop", "Instrumental Rock",
+	"Ethnic", "Gothic", "Darkwave", "Techno-Industrial", "Electronic", "Pop-Folk", "Eurodance", "Dream",
+	"Southern Rock", "Comedy", "Cult", "Gangsta", "Top 40", "Christian Rap", "Pop/Funk", "Jungle",
+	"Native American", "Cabaret", "New Wave", "Psychadelic", "Rave", "Showtunes", "Trailer", "Lo-Fi",
+	"Tribal", "Acid Punk", "Acid Jazz", "Polka", "Retro", "Musical", "Rock & Roll", "Hard Rock",
+
+	// Winamp extensions
+	"Folk", "Folk-Rock", "National Folk", "Swing", "Fast Fusion", "Bebob", "Latin", "Revival",
+	"Celtic", "Bluegrass", "Avantgarde", "Gothic Rock", "Progressive Rock", "Psychedelic Rock", "Symphonic Rock", "Slow Rock",
+	"Big Band", "Chorus", "Easy Listening", "Acoustic", "Humour", "Speech", "Chanson", "Opera",
+	"Chamber Music", "Sonata", "Symphony", "Booty Bass", "Primus", "Porn Groove", "Satire", "Slow Jam",
+	"Club", "Tango", "Samba", "Folklore", "Ballad", "Power Ballad", "Rhythmic Soul", "Freestyle",
+	"Duet", "Punk Rock", "Drum Solo", "A capella", "Euro-House", "Dance Hall", "Goa", "Drum & Bass",
+	"Club-House", "Hardcore", "Terror", "Indie", "BritPop", "Afro-Punk", "Polsk Punk", "Beat",
+	"Christian Gangsta Rap", "Heavy Metal", "Black Metal", "Crossover", "Contemporary Christian", "Christian Rock", "Merengue", "Salsa",
+	"Thrash Metal", "Anime", "JPop", "Synthpop", "Abstract", "Art Rock", "Baroque", "Bhangra",
+	"Big Beat", "Breakbeat", "Chillout", "Downtempo", "Dub", "EBM", "Eclectic", "Electro",
+	"Electroclash", "Emo", "Experimental", "Garage", "Global", "IDM", "Illbient", "Industro-Goth",
+	"Jam Band", "Krautrock", "Leftfield", "Lounge", "Math Rock", "New Romantic", "Nu-Breakz", "Post-Punk",
+	"Post-Rock", "Psytrance", "Shoegaze", "Space Rock", "Trop Rock", "World Music", "Neoclassical", "Audiobook",
+	"Audio Theatre", "Neue Deutsche Welle", "Podcast", "Indie Rock", "G-Funk", "Dubstep", "Garage Rock", "Psybient",
+}
+
+const (
+	genreNone  = 255 // v1 value for no genre
+	genreRemix = "RX"
+	genreCover = "CR"
+)
+
+// GenreName provides the name for a genre index, or an empty string when unknown
+func GenreName(i int) string {
+	if i < 0 || i >= len(Genres) {
+		return ""
+	}
+
+	return Genres[i]
+}
+
+// GenreIndex provides the index for a genre name, ignoring case, or 255 when unknown
+func GenreIndex(n string) int {
+	for k, v := range Genres {
+		if strings.EqualFold(v, strings.TrimSpace(n)) {
+			return k
+		}
+	}
+
+	return genreNone
+}
+
+// ResolveGenres will convert the content of a TCON frame into genre names. Numeric
+// references, parenthesised v2.3 references with refinements, the remix and cover
+// markers, and v2.4 null separated values are all handled.
+func ResolveGenres(s string) []string {
+	out := []string{}
+	add := func(n string) {
+		n = strings.TrimSpace(n)
+		for _, v := range out {
+			if strings.EqualFold(v, n) {
+				return
+			}
+		}
+		if len(n) > 0 {
+			out = append(out, n)
+		}
+	}
+
+	for _, v := range strings.Split(s, "\x00") {
+		v = strings.Trim(v, " \ufeff\ufffe")
+
+		// parenthesised references lead, with an optional refinement following
+		for strings.HasPrefix(v, "(") && !strings.HasPrefix(v, "((") {
+			end := strings.Index(v, ")")
+			if end < 0 {
+				break
+			}
+
+			add(resolveGenre(v[1:end]))
+			v = v[end+1:]
+		}
+
+		// a doubled bracket escapes a refinement starting with one
+		if strings.HasPrefix(v, "((") {
+			v = v[1:]
+		}
+		add(resolveGenre(v))
+	}
+
+	return out
+}
+
+func resolveGenre(s string) string {
+	switch s {
+	case genreRemix:
+		return "Remix"
+	case genreCover:
+		return "Cover"
+	}
+
+	if i, err := strconv.Atoi(s); err == nil {
+		return GenreName(i)
+	}
+
+	return s
+}
@@ -0,0 +1,66 @@
+package id3
+
+import (
+	"testing"
+
+	"github.com/cloudcloud/go-id3/frames"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenreLookups(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("Rock", GenreName(17))
+	assert.Equal("Folk", GenreName(80))
+	assert.Equal("Goa", GenreName(126))
+	assert.Equal("Psybient", GenreName(191))
+	assert.Equal("", GenreName(192))
+	assert.Equal("", GenreName(-1))
+
+	assert.Equal(17, GenreIndex("rock"))
+	assert.Equal(52, GenreIndex("Electronic "))
+	assert.Equal(255, GenreIndex("Not a Genre"))
+}
+
+func TestResolveGenres(t *testing.T) {
+	assert := assert.New(t)
+	cases := map[string][]string{
+		"17":               {"Rock"},
+		"(17)":             {"Rock"},
+		"(17)Rock":         {"Rock"},
+		"(4)(17)Eurodisco": {"Disco", "Rock", "Eurodisco"},
+		"(RX)(CR)":         {"Remix", "Cover"},
+		"((Bracketed)":     {"(Bracketed)"},
+		"Rock\x0052\x00CR": {"Rock", "Electronic", "Cover"},
+		"Post-Rock":        {"Post-Rock"},
+		"(17":              {"(17"},
+		"":                 {},
+	}
+
+	for in, expected := range cases {
+		assert.Equal(expected, ResolveGenres(in), in)
+	}
+}
+
+func TestFileGetGenre(t *testing.T) {
+	assert := assert.New(t)
+
+	x := frames.NewFrame("TCON", "Content type", frames.Version4).(*frames.TEXT)
+	x.Cleaned = "(17)\x00Shoegaze"
+	f := &File{V1: &V1{Major: 1, Genre: 52}, V2: &V2{Frames: []frames.IFrame{x}}}
+	assert.Equal("Rock, Shoegaze", f.GetGenre())
+
+	f.V2.Frames = []frames.IFrame{}
+	assert.Equal("Electronic", f.GetGenre())
+
+	f.V1.Genre = 255
+	assert.Equal("", f.GetGenre())
+}
+
+func TestV1SetGenre(t *testing.T) {
+	v := &V1{}
+	v.SetGenre("Shoegaze")
+	if v.Genre != 178 || v.GetGenre() != "Shoegaze" {
+		t.Fatalf("Got [%d] [%s], Expected [178] [Shoegaze]", v.Genre, v.GetGenre())
+	}
+}
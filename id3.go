@@ -4,8 +4,12 @@ package id3
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/cloudcloud/go-id3/frames"
 	"gopkg.in/yaml.v2"
@@ -18,6 +22,15 @@ type File struct {
 	V2       *V2    `json:"id3v2"`
 	Debug    bool   `json:"-"`
 
+	// FollowSeek will have SEEK frames followed to any further tags, which are
+	// merged into V2 as updates. Each tag found is listed in Tags. A file holding
+	// more than one tag can't be saved, as the later tags would override the edits.
+	FollowSeek bool  `json:"-" yaml:"-"`
+	Tags       []*V2 `json:"-" yaml:"-"`
+
+	// Options decide how tolerant parsing of the v2 tags is
+	Options ParseOptions `json:"-" yaml:"-"`
+
 	fileHandle frames.FrameFile
 }
 
@@ -26,19 +39,190 @@ type Version interface {
 	Parse(frames.FrameFile) error
 }
 
-// Process will begin the opening and loading of File content
-func (f *File) Process(h frames.FrameFile) *File {
+// Process will begin the opening and loading of File content. ErrNoTag is given
+// when there is neither a v1 nor a v2 tag, otherwise any problem found with the v2
+// tag is given as a *ParseError, alongside whatever content could be read.
+func (f *File) Process(h frames.FrameFile) (*File, error) {
 	f.fileHandle = h
 
 	// run through v1
 	f.V1 = &V1{Debug: f.Debug}
-	_ = f.V1.Parse(f.fileHandle)
+	errV1 := f.V1.Parse(f.fileHandle)
 
 	// run through v2
-	f.V2 = &V2{Debug: f.Debug}
-	_ = f.V2.Parse(f.fileHandle)
+	f.V2 = &V2{Debug: f.Debug, Options: f.Options}
+	err := f.V2.Parse(f.fileHandle)
+
+	f.Tags = []*V2{f.V2}
+	if f.FollowSeek && f.V2.Major > 0 {
+		f.followSeek()
+	}
+
+	if errors.Is(err, ErrNoTag) {
+		if errV1 != nil {
+			return f, ErrNoTag
+		}
+
+		return f, nil
+	}
+
+	return f, err
+}
 
-	return f
+// followSeek will trawl each tag pointed to by a SEEK frame, merging them over a copy
+// of the first tag so that the individual tags are left as they were found
+func (f *File) followSeek() {
+	merged := *f.V2
+	merged.Frames = append([]frames.IFrame{}, f.V2.Frames...)
+
+	for t := f.V2; ; {
+		n, err := t.Next(f.fileHandle)
+		if err != nil || n == nil {
+			break
+		}
+
+		f.Tags = append(f.Tags, n)
+		merged.Merge(n)
+		t = n
+	}
+
+	f.V2 = &merged
+}
+
+// Save will write the ID3v2 tag back to the file, along with the ID3v1 tag when one
+// was found. When the new tag fits within the space held by the existing tag and its
+// padding, the tag is overwritten in place. Otherwise the file is rebuilt through a
+// temporary file that replaces the original, after which the file handle is
+// reopened. An appended tag keeps its place within the file. Tags merged through
+// FollowSeek are refused, as the later tags would be left to override the changes.
+// Writing v2.2 tags is not supported, so files holding one are refused and left
+// untouched, including the ID3v1 tag.
+func (f *File) Save() error {
+	if f.fileHandle == nil || f.V2 == nil {
+		return fmt.Errorf("no file has been processed")
+	}
+	if len(f.Tags) > 1 {
+		return fmt.Errorf("saving the [%d] tags merged through SEEK frames is not supported", len(f.Tags))
+	}
+	if f.V2.Major == frames.Version2 {
+		return fmt.Errorf("writing not supported for v2.%d", f.V2.Major)
+	}
+
+	// a file without a tag holds no existing space, so the size is taken first
+	existing := f.V2.TagSize()
+	if f.V2.Major == 0 {
+		f.V2.Major = frames.Version4
+	}
+
+	padding := f.V2.Padding
+	f.V2.Padding = 0
+
+	b, err := f.V2.Marshal()
+	if err != nil {
+		f.V2.Padding = padding
+		return err
+	}
+
+	footer := f.V2.Footer && f.V2.Major == frames.Version4
+	if len(b) == existing || (len(b) < existing && !footer) {
+		f.V2.Padding = existing - len(b)
+		if b, err = f.V2.Marshal(); err != nil {
+			return err
+		}
+
+		if _, err = f.fileHandle.Seek(f.V2.Offset, io.SeekStart); err != nil {
+			return err
+		}
+		if _, err = f.fileHandle.Write(b); err != nil {
+			return err
+		}
+	} else {
+		if !footer {
+			f.V2.Padding = v2DefaultPadding
+		}
+		if b, err = f.V2.Marshal(); err != nil {
+			return err
+		}
+
+		if err = f.rewrite(b, existing); err != nil {
+			return err
+		}
+	}
+
+	f.V2.Size = frames.GetSize(b[v2OffsetSize:v2HeaderLength], bitwiseSeventhShifter)
+
+	if f.V1 != nil && f.V1.Major > 0 {
+		return f.V1.Save(f.fileHandle)
+	}
+
+	return nil
+}
+
+// rewrite will build a replacement file from the tag and the content after the existing
+// tag, swapping it into place with a rename so the original is never partially written.
+func (f *File) rewrite(b []byte, existing int) error {
+	if len(f.Filename) < 1 {
+		return fmt.Errorf("a filename is required to rewrite the file")
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(f.Filename), filepath.Base(f.Filename)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if err = f.copyInto(tmp, b, existing); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	if err = os.Rename(tmp.Name(), f.Filename); err != nil {
+		return err
+	}
+
+	_ = f.fileHandle.Close()
+	h, err := os.OpenFile(f.Filename, os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+	f.fileHandle = h
+
+	return nil
+}
+
+func (f *File) copyInto(tmp *os.File, b []byte, existing int) error {
+	if st, err := os.Stat(f.Filename); err == nil {
+		if err = tmp.Chmod(st.Mode()); err != nil {
+			return err
+		}
+	}
+
+	// anything before an appended tag is kept ahead of it
+	if f.V2.Offset > 0 {
+		if _, err := f.fileHandle.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		if _, err := io.CopyN(tmp, f.fileHandle, f.V2.Offset); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tmp.Write(b); err != nil {
+		return err
+	}
+
+	if _, err := f.fileHandle.Seek(f.V2.Offset+int64(existing), io.SeekStart); err != nil {
+		return err
+	}
+	if _, err := io.Copy(tmp, f.fileHandle); err != nil {
+		return err
+	}
+
+	return tmp.Sync()
 }
 
 // PrettyPrint draws a nice representation of the file for the command line
@@ -73,6 +257,16 @@ func (f *File) GetArtist() string {
 	return a
 }
 
+// GetArtists will determine each of the artists for the song.
+func (f *File) GetArtists() []string {
+	a := f.V2.GetArtists()
+	if len(a) < 1 && len(f.V1.Artist) > 0 {
+		a = []string{f.V1.Artist}
+	}
+
+	return a
+}
+
 // GetAlbum will determine the ideal Album string for use.
 func (f *File) GetAlbum() string {
 	a := f.V2.GetAlbum()
@@ -92,3 +286,20 @@ func (f *File) GetTitle() string {
 
 	return a
 }
+
+// GetGenre will provide the genres found for the song, comma separated.
+func (f *File) GetGenre() string {
+	return strings.Join(f.GetGenres(), ", ")
+}
+
+// GetGenres will provide each of the genres found for the song.
+func (f *File) GetGenres() []string {
+	a := f.V2.GetGenres()
+	if len(a) < 1 && f.V1.Major > 0 {
+		if n := GenreName(f.V1.Genre); len(n) > 0 {
+			a = []string{n}
+		}
+	}
+
+	return a
+}
@@ -4,8 +4,11 @@ import (
 	"bytes"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/cloudcloud/go-id3/frames"
 )
 
 func TestBaseFile(t *testing.T) {
@@ -37,7 +40,7 @@ func TestNoFile(t *testing.T) {
 		"Bobbum                        " +
 		"2016" +
 		"This is just a comment here " +
-		"01\x01"))
+		"\x00\x01\x01"))
 
 	f := &File{Debug: false}
 	f.Process(b)
@@ -59,7 +62,7 @@ func TestNoV2(t *testing.T) {
 		"Bobbum                        " +
 		"2016" +
 		"This is just a comment here " +
-		"01\x01"))
+		"\x00\x01\x01"))
 
 	f := &File{Debug: false}
 	f.Process(b)
@@ -81,15 +84,15 @@ func TestNoV2Json(t *testing.T) {
 		"Bobbum                        " +
 		"2016" +
 		"This is just a comment here " +
-		"01\x01"))
+		"\x00\x01\x01"))
 
 	f := &File{Debug: false}
 	f.Process(b)
 
-	expected := `{"filename":"","id3v1":{"artist":"Bob","title":"Bob is great","album":"Bobbum","year":2016,` +
-		`"comment":"This is just a comment here","track":1,"genre":0},"id3v2":{"frames":[],"major_version":0,` +
+	expected := `{"filename":"","id3v1":{"major_version":1,"min_version":1,"artist":"Bob","title":"Bob is great","album":"Bobbum","year":2016,` +
+		`"comment":"This is just a comment here","track":1,"genre":1},"id3v2":{"frames":[],"major_version":0,` +
 		`"min_version":0,"flag":0,"tag_size":0,"unsynchronised":false,"extended":false,"experimental":false,` +
-		`"footer":false,"extended_size":0,"extended_flag":null,"extended_padding":0,"crc":false,"crc_content":null}}`
+		`"footer":false,"padding":0,"offset":0,"extended_size":0,"extended_flag":null,"extended_padding":0,"crc":false,"crc_content":null,"crc_value":0,"crc_valid":false,"is_update":false,"restrictions":null}}`
 
 	var o bytes.Buffer
 	f.PrettyPrint(&o, "json")
@@ -107,20 +110,22 @@ func TestNoV2Yaml(t *testing.T) {
 		"Bobbum                        " +
 		"2016" +
 		"This is just a comment here " +
-		"01\x01"))
+		"\x00\x01\x01"))
 
 	f := &File{Debug: false}
 	f.Process(b)
 
 	expected := `filename: ""
 v1:
+  major: 1
+  min: 1
   artist: Bob
   title: Bob is great
   album: Bobbum
   year: 2016
   comment: This is just a comment here
   track: 1
-  genre: 0
+  genre: 1
   debug: false
 v2:
   frames: []
@@ -132,11 +137,17 @@ v2:
   extended: false
   experimental: false
   footer: false
+  padding: 0
+  offset: 0
   extended_size: 0
   extended_flag: []
   extended_padding: 0
   crc: false
   crc_content: []
+  crc_value: 0
+  crc_valid: false
+  is_update: false
+  restrictions: null
   debug: false
 debug: false`
 
@@ -189,3 +200,169 @@ func (t *tfile) Write(b []byte) (int, error) {
 
 	return t.buf.Len(), nil
 }
+
+func TestSaveInPlace(t *testing.T) {
+	audio := "\xff\xfb\x90\x00audio content"
+	name := writeTemp(t, "ID3\x03\x00\x00\x00\x00\x00\x2b"+
+		"TPE1\x00\x00\x00\x0d\x00\x00\x00Cult of Luna"+
+		"\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00"+
+		audio)
+
+	f := processTemp(t, name)
+	f.V2.GetFrame("TPE1").(*frames.TEXT).Cleaned = "Isis"
+	if err := f.Save(); err != nil {
+		t.Fatalf("Unable to Save(), [%s]", err)
+	}
+
+	b, _ := os.ReadFile(name)
+	if len(b) != 53+len(audio) {
+		t.Fatalf("Expected an in place write, file is now [%d] bytes", len(b))
+	}
+	if !strings.HasSuffix(string(b), audio) {
+		t.Fatalf("Audio content was not preserved, got [%#v]", b)
+	}
+
+	found := processTemp(t, name).GetArtist()
+	if found != "Isis" {
+		t.Fatalf("Got [%s], Expected [Isis]", found)
+	}
+}
+
+func TestSaveRewrite(t *testing.T) {
+	audio := "\xff\xfb\x90\x00audio content"
+	name := writeTemp(t, "ID3\x03\x00\x00\x00\x00\x00\x17"+
+		"TPE1\x00\x00\x00\x0d\x00\x00\x00Cult of Luna"+
+		audio)
+
+	f := processTemp(t, name)
+	f.V2.GetFrame("TPE1").(*frames.TEXT).Cleaned = "Cult of Luna and Julie Christmas"
+	if err := f.Save(); err != nil {
+		t.Fatalf("Unable to Save(), [%s]", err)
+	}
+
+	b, _ := os.ReadFile(name)
+	if !strings.HasSuffix(string(b), audio) {
+		t.Fatalf("Audio content was not preserved, got [%#v]", b)
+	}
+
+	x := processTemp(t, name)
+	if x.GetArtist() != "Cult of Luna and Julie Christmas" {
+		t.Fatalf("Got [%s], Expected [Cult of Luna and Julie Christmas]", x.GetArtist())
+	}
+	if x.V2.Padding != v2DefaultPadding {
+		t.Fatalf("Got [%d], Expected [%d] padding", x.V2.Padding, v2DefaultPadding)
+	}
+
+	// the reopened handle keeps working for in place writes
+	f.V2.GetFrame("TPE1").(*frames.TEXT).Cleaned = "Julie Christmas"
+	if err := f.Save(); err != nil {
+		t.Fatalf("Unable to Save(), [%s]", err)
+	}
+
+	c, _ := os.ReadFile(name)
+	if len(c) != len(b) {
+		t.Fatalf("Expected an in place write, file went from [%d] to [%d] bytes", len(b), len(c))
+	}
+}
+
+func TestSaveWithoutTag(t *testing.T) {
+	audio := "\xff\xfb\x90\x00\x01\x02\x03\x04\x05\x06\x07\x08 audio content"
+	for _, add := range []bool{true, false} {
+		name := writeTemp(t, audio)
+
+		f := processTemp(t, name)
+		if add {
+			x := frames.NewFrame("TIT2", "Title", frames.Version4).(*frames.TEXT)
+			x.SetValues("Eternal Kingdom")
+			f.V2.Frames = append(f.V2.Frames, x)
+		}
+		if err := f.Save(); err != nil {
+			t.Fatalf("Unable to Save(), [%s]", err)
+		}
+
+		b, _ := os.ReadFile(name)
+		if len(b) != f.V2.TagSize()+len(audio) || string(b[f.V2.TagSize():]) != audio {
+			t.Fatalf("Audio content was not preserved, got [%#v]", b)
+		}
+
+		x := processTemp(t, name)
+		if add && x.GetTitle() != "Eternal Kingdom" {
+			t.Fatalf("Got [%s], Expected [Eternal Kingdom]", x.GetTitle())
+		}
+	}
+}
+
+func TestSaveMalformedFrame(t *testing.T) {
+	audio := "\xff\xfb\x90\x00audio content"
+	popm := "POPM\x00\x00\x00\x05\x00\x00abcde"
+	name := writeTemp(t, "ID3\x03\x00\x00\x00\x00\x00\x26"+
+		"TPE1\x00\x00\x00\x0d\x00\x00\x00Cult of Luna"+
+		popm+
+		audio)
+
+	f := processTemp(t, name)
+	f.V2.GetFrame("TPE1").(*frames.TEXT).Cleaned = "Isis"
+	if err := f.Save(); err != nil {
+		t.Fatalf("Unable to Save(), [%s]", err)
+	}
+
+	b, _ := os.ReadFile(name)
+	if !strings.Contains(string(b), popm) {
+		t.Fatalf("Malformed frame was not preserved, got [%#v]", b)
+	}
+
+	x := processTemp(t, name)
+	u, ok := x.V2.GetFrame("POPM").(*frames.UNKNOWN)
+	if !ok || string(u.Raw) != "abcde" {
+		t.Fatalf("Got [%#v], Expected the malformed frame as found", x.V2.GetFrame("POPM"))
+	}
+	if x.GetArtist() != "Isis" {
+		t.Fatalf("Got [%s], Expected [Isis]", x.GetArtist())
+	}
+}
+
+func TestSaveVersion22(t *testing.T) {
+	content := "ID3\x02\x00\x00\x00\x00\x00\x13" +
+		"TT2\x00\x00\x0d\x00Cult of Luna" +
+		"\xff\xfb\x90\x00audio content"
+	name := writeTemp(t, content)
+
+	f := processTemp(t, name)
+	f.V2.GetFrame("TT2").(*frames.TEXT).SetValues("Isis")
+	if err := f.Save(); err == nil {
+		t.Fatal("Expected an error saving a v2.2 tag")
+	}
+
+	b, _ := os.ReadFile(name)
+	if string(b) != content {
+		t.Fatalf("Expected the file to be untouched, got [%#v]", b)
+	}
+}
+
+func TestSaveWithoutFile(t *testing.T) {
+	f := &File{}
+	if err := f.Save(); err == nil {
+		t.Fatal("Expected an error saving without a file")
+	}
+}
+
+func writeTemp(t *testing.T, content string) string {
+	name := filepath.Join(t.TempDir(), "test.mp3")
+	if err := os.WriteFile(name, []byte(content), 0600); err != nil {
+		t.Fatalf("Unable to write file, [%s]", err)
+	}
+
+	return name
+}
+
+func processTemp(t *testing.T, name string) *File {
+	h, err := os.OpenFile(name, os.O_RDWR, 0)
+	if err != nil {
+		t.Fatalf("Unable to open file, [%s]", err)
+	}
+	t.Cleanup(func() { _ = h.Close() })
+
+	f, _ := (&File{Filename: name}).Process(h)
+
+	return f
+}
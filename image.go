@@ -0,0 +1,126 @@
+package id3
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+var imageMagic = []struct {
+	magic  []byte
+	offset int
+	mime   string
+}{
+	{[]byte("\xff\xd8\xff"), 0, "image/jpeg"},
+	{[]byte("\x89PNG\r\n\x1a\n"), 0, "image/png"},
+	{[]byte("GIF87a"), 0, "image/gif"},
+	{[]byte("GIF89a"), 0, "image/gif"},
+	{[]byte("WEBP"), 8, "image/webp"},
+	{[]byte("BM"), 0, "image/bmp"},
+	{[]byte("II*\x00"), 0, "image/tiff"},
+	{[]byte("MM\x00*"), 0, "image/tiff"},
+}
+
+// ImageMimeType will determine the MIME type of the image from the magic bytes it
+// begins with, giving an empty string when the format isn't recognised
+func ImageMimeType(d []byte) string {
+	for _, v := range imageMagic {
+		if len(d) < v.offset+len(v.magic) || !bytes.Equal(d[v.offset:v.offset+len(v.magic)], v.magic) {
+			continue
+		}
+		if v.mime == "image/webp" && !bytes.HasPrefix(d, []byte("RIFF")) {
+			continue
+		}
+
+		return v.mime
+	}
+
+	return ""
+}
+
+// ImageDimensions will read the width and height in pixels from the headers of a
+// JPEG, PNG, GIF or WebP image, with ok being false when they can't be found
+func ImageDimensions(d []byte) (w, h int, ok bool) {
+	switch ImageMimeType(d) {
+	case "image/jpeg":
+		return jpegDimensions(d)
+
+	case "image/png":
+		if len(d) < 24 || string(d[12:16]) != "IHDR" {
+			return 0, 0, false
+		}
+		return int(binary.BigEndian.Uint32(d[16:20])), int(binary.BigEndian.Uint32(d[20:24])), true
+
+	case "image/gif":
+		if len(d) < 10 {
+			return 0, 0, false
+		}
+		return int(binary.LittleEndian.Uint16(d[6:8])), int(binary.LittleEndian.Uint16(d[8:10])), true
+
+	case "image/webp":
+		return webpDimensions(d)
+	}
+
+	return 0, 0, false
+}
+
+// jpegDimensions walks the JPEG segments to the start of frame, which holds the
+// height ahead of the width
+func jpegDimensions(d []byte) (int, int, bool) {
+	for i := 2; i+3 < len(d); {
+		if d[i] != 0xff {
+			return 0, 0, false
+		}
+
+		m := d[i+1]
+		switch {
+		case m == 0xff:
+			// fill bytes may come ahead of a marker
+			i++
+			continue
+
+		case m == 0x01 || (m >= 0xd0 && m <= 0xd8):
+			// markers standing alone, without a length
+			i += 2
+			continue
+
+		case m >= 0xc0 && m <= 0xcf && m != 0xc4 && m != 0xc8 && m != 0xcc:
+			if i+9 > len(d) {
+				return 0, 0, false
+			}
+			return int(binary.BigEndian.Uint16(d[i+7 : i+9])), int(binary.BigEndian.Uint16(d[i+5 : i+7])), true
+		}
+
+		i += 2 + int(binary.BigEndian.Uint16(d[i+2:i+4]))
+	}
+
+	return 0, 0, false
+}
+
+// webpDimensions reads the canvas size from whichever of the lossy, lossless or
+// extended chunks the image begins with
+func webpDimensions(d []byte) (int, int, bool) {
+	if len(d) < 25 {
+		return 0, 0, false
+	}
+
+	switch string(d[12:16]) {
+	case "VP8L":
+		b := binary.LittleEndian.Uint32(d[21:25])
+		return int(b&0x3fff) + 1, int(b>>14&0x3fff) + 1, true
+
+	case "VP8 ":
+		if len(d) < 30 {
+			return 0, 0, false
+		}
+		return int(binary.LittleEndian.Uint16(d[26:28]) & 0x3fff), int(binary.LittleEndian.Uint16(d[28:30]) & 0x3fff), true
+
+	case "VP8X":
+		if len(d) < 30 {
+			return 0, 0, false
+		}
+		return int(uint32(d[24])|uint32(d[25])<<8|uint32(d[26])<<16) + 1,
+			int(uint32(d[27])|uint32(d[28])<<8|uint32(d[29])<<16) + 1, true
+	}
+
+	return 0, 0, false
+}
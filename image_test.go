@@ -0,0 +1,73 @@
+package id3
+
+import (
+	"bytes"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func pngImage(w, h int) []byte {
+	b := &bytes.Buffer{}
+	_ = png.Encode(b, image.NewGray(image.Rect(0, 0, w, h)))
+
+	return b.Bytes()
+}
+
+func TestImageMimeType(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("image/png", ImageMimeType(pngImage(1, 1)))
+	assert.Equal("image/jpeg", ImageMimeType([]byte("\xff\xd8\xff\xe0")))
+	assert.Equal("image/gif", ImageMimeType([]byte("GIF89a\x01\x00\x01\x00")))
+	assert.Equal("image/webp", ImageMimeType([]byte("RIFF\x00\x00\x00\x00WEBPVP8 ")))
+	assert.Equal("", ImageMimeType([]byte("RIFF\x00\x00\x00\x00WAVEfmt ")))
+	assert.Equal("image/bmp", ImageMimeType([]byte("BM\x00\x00")))
+	assert.Equal("image/tiff", ImageMimeType([]byte("II*\x00")))
+	assert.Equal("", ImageMimeType([]byte("text")))
+	assert.Equal("", ImageMimeType(nil))
+}
+
+func TestImageDimensions(t *testing.T) {
+	assert := assert.New(t)
+	img := image.NewRGBA(image.Rect(0, 0, 40, 30))
+
+	j := &bytes.Buffer{}
+	_ = jpeg.Encode(j, img, nil)
+	g := &bytes.Buffer{}
+	_ = gif.Encode(g, img, nil)
+
+	tests := map[string][]byte{
+		"png":       pngImage(40, 30),
+		"jpeg":      j.Bytes(),
+		"gif":       g.Bytes(),
+		"webp":      []byte("RIFF\x00\x00\x00\x00WEBPVP8 \x00\x00\x00\x00\x00\x00\x00\x9d\x01\x2a\x28\x00\x1e\x00"),
+		"webp-vp8l": []byte("RIFF\x00\x00\x00\x00WEBPVP8L\x00\x00\x00\x00\x2f\x27\x40\x07\x00"),
+		"webp-vp8x": []byte("RIFF\x00\x00\x00\x00WEBPVP8X\x0a\x00\x00\x00\x00\x00\x00\x00\x27\x00\x00\x1d\x00\x00"),
+	}
+	for k, v := range tests {
+		w, h, ok := ImageDimensions(v)
+		assert.True(ok, k)
+		assert.Equal(40, w, k)
+		assert.Equal(30, h, k)
+	}
+
+	for _, v := range [][]byte{nil, []byte("\xff\xd8\xff\xe0\x00"), pngImage(1, 1)[:20], []byte("BM\x00\x00")} {
+		_, _, ok := ImageDimensions(v)
+		assert.False(ok)
+	}
+}
+
+func FuzzImageDimensions(f *testing.F) {
+	f.Add(pngImage(2, 2))
+	f.Add([]byte("\xff\xd8\xff\xe0\x00\x04\x00\x00\xff\xc0\x00\x11\x08\x00\x1e\x00\x28"))
+	f.Add([]byte("RIFF\x00\x00\x00\x00WEBPVP8X\x0a\x00\x00\x00\x00\x00\x00\x00\x27\x00\x00\x1d\x00\x00"))
+
+	f.Fuzz(func(t *testing.T, d []byte) {
+		_, _, _ = ImageDimensions(d)
+	})
+}
@@ -0,0 +1,117 @@
+package id3
+
+import (
+	"encoding/binary"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/cloudcloud/go-id3/frames"
+)
+
+const (
+	v1ExtendedInit = "TAG+" // enhanced v1 block sitting before the v1 tag
+	v1ExtendedSize = 227    // bytes in the enhanced v1 block
+
+	lyrics3Begin      = "LYRICSBEGIN"
+	lyrics3End        = "LYRICSEND" // marks the end of a Lyrics3 v1 tag
+	lyrics3V2End      = "LYRICS200" // marks the end of a Lyrics3 v2 tag
+	lyrics3SizeLength = 6           // ascii digits for the Lyrics3 v2 size
+	lyrics3MaxSize    = 5100        // largest Lyrics3 v1 content
+
+	apeInit         = "APETAGEX"
+	apeFooterLength = 32      // bytes in both the APE header and footer
+	apeHeaderFlag   = 1 << 31 // footer flag denoting the presence of a header
+)
+
+// findAppended will search from the end of the file for a v2.4 tag marked by its
+// footer, walking back over any v1, Lyrics3 and APE tags that follow it. The offset
+// of the tag header is provided, or -1 when no appended tag is found.
+func findAppended(h frames.FrameFile) int64 {
+	end, err := h.Seek(0, io.SeekEnd)
+	if err != nil {
+		return -1
+	}
+
+	for end >= v2HeaderLength*2 {
+		b := readAt(h, end-v2HeaderLength, v2HeaderLength)
+		if string(b[:v2HeaderOffset]) == v2FooterInit {
+			start := end - int64(v2HeaderLength*2+frames.GetSize(b[v2OffsetSize:], bitwiseSeventhShifter))
+			if start >= 0 && string(readAt(h, start, v2HeaderOffset)) == v2HeaderInit {
+				return start
+			}
+		}
+
+		next := skipTrailer(h, end)
+		if next == end {
+			break
+		}
+		end = next
+	}
+
+	return -1
+}
+
+// skipTrailer will provide the offset where a trailing tag ending at end begins, or
+// end itself when there is no tag recognised there
+func skipTrailer(h frames.FrameFile, end int64) int64 {
+	if end >= v1TagSize && string(readAt(h, end-v1TagSize, v1TagStart)) == v1TagInit {
+		end -= v1TagSize
+		if end >= v1ExtendedSize && string(readAt(h, end-v1ExtendedSize, len(v1ExtendedInit))) == v1ExtendedInit {
+			end -= v1ExtendedSize
+		}
+
+		return end
+	}
+
+	if end >= apeFooterLength {
+		b := readAt(h, end-apeFooterLength, apeFooterLength)
+		if string(b[:len(apeInit)]) == apeInit {
+			size := int64(binary.LittleEndian.Uint32(b[12:16]))
+			if binary.LittleEndian.Uint32(b[20:24])&apeHeaderFlag != 0 {
+				size += apeFooterLength
+			}
+
+			if size >= apeFooterLength && size <= end {
+				return end - size
+			}
+		}
+	}
+
+	tail := int64(lyrics3SizeLength + len(lyrics3V2End))
+	if end >= tail {
+		b := readAt(h, end-tail, int(tail))
+		switch string(b[lyrics3SizeLength:]) {
+		case lyrics3V2End:
+			size, err := strconv.Atoi(string(b[:lyrics3SizeLength]))
+			if err == nil && int64(size)+tail <= end {
+				return end - int64(size) - tail
+			}
+
+		case lyrics3End:
+			// v1 has no size, so the start marker is searched for
+			l := int64(lyrics3MaxSize + len(lyrics3Begin) + len(lyrics3End))
+			if l > end {
+				l = end
+			}
+
+			idx := strings.LastIndex(string(readAt(h, end-l, int(l))), lyrics3Begin)
+			if idx >= 0 {
+				return end - l + int64(idx)
+			}
+		}
+	}
+
+	return end
+}
+
+// readAt will read l bytes from the offset, leaving any that can't be read as zero
+func readAt(h frames.FrameFile, o int64, l int) []byte {
+	b := make([]byte, l)
+	if _, err := h.Seek(o, io.SeekStart); err != nil {
+		return b
+	}
+	_, _ = io.ReadFull(h, b)
+
+	return b
+}
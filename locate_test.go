@@ -0,0 +1,63 @@
+package id3
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/cloudcloud/go-id3/frames"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	appendedTag = "ID3\x04\x00\x10\x00\x00\x00\x17" +
+		"TPE1\x00\x00\x00\x0d\x00\x00\x00Cult of Luna" +
+		"3DI\x04\x00\x10\x00\x00\x00\x17"
+	audio = "\xff\xfb\x90\x00audio content"
+)
+
+func TestFindAppended(t *testing.T) {
+	assert := assert.New(t)
+	v1 := "TAG" + strings.Repeat("\x00", 125)
+	ape := "junk" + "APETAGEX\xd0\x07\x00\x00\x24\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00" + strings.Repeat("\x00", 8)
+	lyrics2 := "LYRICSBEGININD0000210" + "000021LYRICS200"
+	lyrics1 := "LYRICSBEGINhello" + "LYRICSEND"
+
+	cases := map[string]string{
+		"bare":       audio + appendedTag,
+		"v1":         audio + appendedTag + v1,
+		"extended":   audio + appendedTag + "TAG+" + strings.Repeat("\x00", 223) + v1,
+		"ape":        audio + appendedTag + ape + v1,
+		"lyrics3 v2": audio + appendedTag + lyrics2 + v1,
+		"lyrics3 v1": audio + appendedTag + lyrics1,
+	}
+
+	for k, c := range cases {
+		f := processTemp(t, writeTemp(t, c))
+		assert.Equal(int64(len(audio)), f.V2.Offset, k)
+		assert.Equal("Cult of Luna", f.V2.GetArtist(), k)
+	}
+
+	f := processTemp(t, writeTemp(t, audio+"TAG"+strings.Repeat("\x00", 125)))
+	assert.Equal(0, f.V2.Major)
+	assert.Equal(int64(0), f.V2.Offset)
+}
+
+func TestSaveAppended(t *testing.T) {
+	assert := assert.New(t)
+	v1 := "TAG" + strings.Repeat("\x00", 125)
+	name := writeTemp(t, audio+appendedTag+v1)
+
+	f := processTemp(t, name)
+	f.V2.Frames[0].(*frames.TEXT).SetValues("Isis")
+	assert.Nil(f.Save())
+
+	b, err := os.ReadFile(name)
+	assert.Nil(err)
+	assert.True(strings.HasPrefix(string(b), audio+"ID3\x04"))
+	assert.True(strings.HasSuffix(string(b), v1))
+
+	x := processTemp(t, name)
+	assert.Equal(int64(len(audio)), x.V2.Offset)
+	assert.Equal("Isis", x.V2.GetArtist())
+}
@@ -0,0 +1,43 @@
+package id3
+
+// ParseMode decides how content that doesn't follow the specification is handled
+type ParseMode int
+
+const (
+	// ParseDefault keeps frames that can't be read as unknown frames, giving the
+	// first of them as the error. Other spec violations are listed in Warnings.
+	ParseDefault ParseMode = iota
+	// ParseStrict stops at the first spec violation, giving it as the error
+	ParseStrict
+	// ParseLenient recovers as much as possible, listing every problem in Warnings
+	// rather than giving an error for them
+	ParseLenient
+)
+
+// ParseOptions are used to decide how tolerant parsing is, so that each source of
+// files can be treated differently. The zero value is the default mode without any
+// limits.
+type ParseOptions struct {
+	Mode ParseMode
+
+	// MaxTagSize is the largest tag accepted in bytes, with larger tags rejected in
+	// every mode. Zero is no limit.
+	MaxTagSize int
+	// MaxFrameSize is the largest frame content accepted in bytes. Larger frames
+	// are skipped with a warning, or rejected when strict. Compressed content is
+	// held to it once inflated, with frames.MaxInflateSize used when it is zero.
+	// Otherwise zero is no limit.
+	MaxFrameSize int
+}
+
+// violation will handle a problem that parsing can recover from. Strict mode gives
+// it back to stop parsing, otherwise it is listed as a warning.
+func (f *V2) violation(err error) error {
+	if f.Options.Mode == ParseStrict {
+		return err
+	}
+
+	f.Warnings = append(f.Warnings, err)
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package id3
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/cloudcloud/go-id3/frames"
+	"github.com/stretchr/testify/assert"
+)
+
+func parseWith(o ParseOptions, tag string) (*V2, error) {
+	b := &tfile{}
+	_, _ = b.Write([]byte(tag))
+	v := &V2{Options: o}
+
+	return v, v.Parse(b)
+}
+
+func TestParseModes(t *testing.T) {
+	assert := assert.New(t)
+	tag := "ID3\x04\x00\x00\x00\x00\x00\x4b" +
+		"TPE1\x00\x00\x00\x0d\x00\x00\x00Cult of Luna" +
+		"tpe1\x00\x00\x00\x0d\x00\x00\x00Cult of Luna" +
+		"TIT2\x00\x00\x00\x07\x00\x00\x00Somnus" +
+		"SEEK\x00\x00\x00\x02\x00\x00\x00\x01"
+
+	v, err := parseWith(ParseOptions{Mode: ParseStrict}, tag)
+	assert.True(errors.Is(err, ErrInvalidFrameID), "%s", err)
+	var pe *ParseError
+	assert.True(errors.As(err, &pe))
+	assert.Equal(int64(33), pe.Offset)
+	assert.Equal(1, len(v.Frames))
+
+	v, err = parseWith(ParseOptions{}, tag)
+	assert.True(errors.Is(err, ErrTruncated), "%s", err)
+	assert.Equal(1, len(v.Warnings))
+	assert.True(errors.Is(v.Warnings[0], ErrInvalidFrameID))
+	assert.Equal(3, len(v.Frames))
+	assert.Equal("Somnus", v.GetTitle())
+
+	// the short SEEK frame is kept as it was found
+	_, ok := v.Frames[2].(*frames.UNKNOWN)
+	assert.True(ok)
+	assert.Equal([]byte("\x00\x01"), v.Frames[2].(*frames.UNKNOWN).Raw)
+
+	v, err = parseWith(ParseOptions{Mode: ParseLenient}, tag)
+	assert.Nil(err)
+	assert.Equal(3, len(v.Frames))
+	assert.Equal("Somnus", v.GetTitle())
+	assert.Equal(2, len(v.Warnings))
+	assert.True(errors.Is(v.Warnings[1], ErrTruncated))
+}
+
+func TestParseInvalidFrameV22(t *testing.T) {
+	assert := assert.New(t)
+	tag := "ID3\x02\x00\x00\x00\x00\x00\x2d" +
+		"TP1\x00\x00\x0d\x00Cult of Luna" +
+		"tt2\x00\x00\x07\x00Somnus" +
+		"TT2\x00\x00\x07\x00Somnus"
+
+	v, err := parseWith(ParseOptions{}, tag)
+	assert.Nil(err)
+	assert.Equal(2, len(v.Frames))
+	assert.Equal([]string{"Cult of Luna"}, v.GetArtists())
+	assert.Equal("Somnus", v.GetTitle())
+	assert.Equal(1, len(v.Warnings))
+	assert.True(errors.Is(v.Warnings[0], ErrInvalidFrameID))
+}
+
+func TestParseLenientTruncated(t *testing.T) {
+	assert := assert.New(t)
+	tag := "ID3\x03\x00\x00\x00\x00\x00\x26" +
+		"TPE1\x00\x00\x00\x0d\x00\x00\x00Cult of Luna" +
+		"TIT2\x00\x00\x00\x20\x00\x00\x00Short"
+
+	v, err := parseWith(ParseOptions{Mode: ParseLenient}, tag)
+	assert.Nil(err)
+	assert.Equal("Cult of Luna", v.GetArtist())
+	assert.Equal(1, len(v.Warnings))
+
+	var pe *ParseError
+	assert.True(errors.As(v.Warnings[0], &pe))
+	assert.Equal("TIT2", pe.Frame)
+}
+
+func TestParseNotSynchsafe(t *testing.T) {
+	assert := assert.New(t)
+	artist := strings.Repeat("a", 0x7f)
+	tag := "ID3\x04\x00\x00\x00\x00\x01\x0a" +
+		"TPE1\x00\x00\x00\x80\x00\x00\x00" + artist
+
+	v, err := parseWith(ParseOptions{}, tag)
+	assert.Nil(err)
+	assert.Equal(artist, v.GetArtist())
+	assert.Equal(1, len(v.Warnings))
+	assert.True(errors.Is(v.Warnings[0], ErrNotSynchsafe))
+
+	_, err = parseWith(ParseOptions{Mode: ParseStrict}, tag)
+	assert.True(errors.Is(err, ErrNotSynchsafe))
+
+	_, err = parseWith(ParseOptions{Mode: ParseStrict}, "ID3\x03\x00\x00\x00\x00\x00\x80")
+	assert.True(errors.Is(err, ErrNotSynchsafe))
+}
+
+func TestParseMaxSizes(t *testing.T) {
+	assert := assert.New(t)
+	tag := "ID3\x03\x00\x00\x00\x00\x00\x2c" +
+		"TIT2\x00\x00\x00\x0b\x00\x00\x00Long Title" +
+		"TPE1\x00\x00\x00\x0d\x00\x00\x00Cult of Luna"
+
+	for _, m := range []ParseMode{ParseDefault, ParseStrict, ParseLenient} {
+		_, err := parseWith(ParseOptions{Mode: m, MaxTagSize: 53}, tag)
+		assert.True(errors.Is(err, ErrTooLarge))
+	}
+
+	v, err := parseWith(ParseOptions{MaxTagSize: 54, MaxFrameSize: 12}, tag)
+	assert.Nil(err)
+	assert.Equal("Long Title", v.GetTitle())
+	assert.Equal("", v.GetArtist())
+	assert.Equal(1, len(v.Warnings))
+	assert.True(errors.Is(v.Warnings[0], ErrTooLarge))
+
+	v, err = parseWith(ParseOptions{MaxFrameSize: 10}, tag)
+	assert.Nil(err)
+	assert.Equal(0, len(v.Frames))
+	assert.Equal(2, len(v.Warnings))
+
+	_, err = parseWith(ParseOptions{Mode: ParseStrict, MaxFrameSize: 12}, tag)
+	assert.True(errors.Is(err, ErrTooLarge))
+}
+
+func TestParseMaxInflated(t *testing.T) {
+	assert := assert.New(t)
+	title := strings.Repeat("a", 1000)
+	flags, d := (&frames.Frame{}).PutFlags(frames.Version3, false, true, []byte("\x00"+title))
+	body := "TIT2" + string(frames.PutSize(len(d), 4, 8)) + string(frames.PutSize(flags, 2, 8)) + string(d)
+	tag := "ID3\x03\x00\x00" + string(frames.PutSize(len(body), 4, 7)) + body
+
+	v, err := parseWith(ParseOptions{}, tag)
+	assert.Nil(err)
+	assert.Equal(title, v.GetTitle())
+
+	// the compressed size is within the limit, while the inflated content isn't
+	v, err = parseWith(ParseOptions{Mode: ParseLenient, MaxFrameSize: 100}, tag)
+	assert.Nil(err)
+	assert.Equal("", v.GetTitle())
+	assert.Equal(1, len(v.Warnings))
+	assert.True(errors.Is(v.Warnings[0], ErrTooLarge))
+	_, ok := v.Frames[0].(*frames.UNKNOWN)
+	assert.True(ok)
+}
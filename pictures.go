@@ -0,0 +1,173 @@
+package id3
+
+import (
+	"fmt"
+
+	"github.com/cloudcloud/go-id3/frames"
+)
+
+const fileIconSize = 32
+
+// Picture is an attached picture, from either an APIC frame or the v2.2 PIC frame.
+// The MIME type is taken from the image content when it can be recognised, with the
+// type given by the frame used otherwise.
+type Picture struct {
+	Type        frames.PictureType `json:"type"`
+	MimeType    string             `json:"mime_type" yaml:"mime_type"`
+	Description string             `json:"description"`
+	Data        []byte             `json:"data" yaml:"data"`
+	Width       int                `json:"width"`
+	Height      int                `json:"height"`
+
+	// Frame is the APIC or PIC frame the picture was found within
+	Frame frames.IFrame `json:"-" yaml:"-"`
+}
+
+// Pictures provides each of the pictures attached within the tag
+func (f *V2) Pictures() []*Picture {
+	out := []*Picture{}
+	for _, v := range f.Frames {
+		if p, ok := v.(frames.IPicture); ok {
+			out = append(out, newPicture(p))
+		}
+	}
+
+	return out
+}
+
+// SetPicture will attach the picture to the tag. Following the spec, descriptions are
+// unique and only a single picture of each file icon type is held, so any picture
+// sharing the description, or the icon type, is replaced.
+func (f *V2) SetPicture(p *Picture) error {
+	if !p.Type.Known() {
+		return fmt.Errorf("picture type [%d] is not known", p.Type)
+	}
+
+	mime := p.MimeType
+	if m := ImageMimeType(p.Data); len(m) > 0 {
+		mime = m
+	}
+	if len(mime) < 1 {
+		return fmt.Errorf("picture [%s] has no MIME type and the image isn't recognised", p.Description)
+	}
+
+	if p.Type == frames.PictureFileIcon {
+		w, h, _ := ImageDimensions(p.Data)
+		if mime != "image/png" || w != fileIconSize || h != fileIconSize {
+			return fmt.Errorf("file icon must be a %dx%d PNG", fileIconSize, fileIconSize)
+		}
+	}
+
+	version := f.Major
+	if version == 0 {
+		version = frames.Version4
+	}
+
+	name := "APIC"
+	if version == frames.Version2 {
+		name = "PIC"
+	}
+	fr, ok := frames.NewFrame(name, "Attached picture", version).(frames.IPicture)
+	if !ok {
+		return fmt.Errorf("frame [%s] doesn't hold a picture", name)
+	}
+
+	a := fr.GetPicture()
+	a.Encoding = descriptionEncoding(p.Description, version)
+	a.MimeType = mime
+	a.SetPictureType(p.Type)
+	a.Title = p.Description
+	a.Image = p.Data
+	a.Size = len(p.Data)
+
+	f.removePictures(func(x *Picture) bool {
+		return x.Description == p.Description || (x.Type == p.Type && (p.Type == frames.PictureFileIcon || p.Type == frames.PictureOtherFileIcon))
+	})
+	f.Frames = append(f.Frames, fr)
+
+	return nil
+}
+
+// RemovePicture will remove the pictures of the type with the description, giving
+// whether any were found
+func (f *V2) RemovePicture(t frames.PictureType, description string) bool {
+	return f.removePictures(func(x *Picture) bool {
+		return x.Type == t && x.Description == description
+	}) > 0
+}
+
+// removePictures drops the picture frames matching, giving the number removed
+func (f *V2) removePictures(match func(*Picture) bool) int {
+	kept := []frames.IFrame{}
+	for _, v := range f.Frames {
+		if p, ok := v.(frames.IPicture); ok && match(newPicture(p)) {
+			continue
+		}
+		kept = append(kept, v)
+	}
+
+	removed := len(f.Frames) - len(kept)
+	f.Frames = kept
+
+	return removed
+}
+
+func newPicture(p frames.IPicture) *Picture {
+	a := p.GetPicture()
+	x := &Picture{
+		Type:        a.PictureType,
+		MimeType:    a.MimeType,
+		Description: a.Title,
+		Data:        a.Image,
+		Frame:       p,
+	}
+
+	if m := ImageMimeType(a.Image); len(m) > 0 {
+		x.MimeType = m
+	}
+	x.Width, x.Height, _ = ImageDimensions(a.Image)
+
+	return x
+}
+
+// descriptionEncoding provides the encoding needed for the description, preferring
+// ISO-8859-1 when every character fits within it
+func descriptionEncoding(s string, version int) byte {
+	for _, r := range s {
+		if r <= '\xff' {
+			continue
+		}
+		if version == frames.Version4 {
+			return frames.EncodingUTF8
+		}
+
+		return frames.EncodingUTF16
+	}
+
+	return frames.EncodingLatin1
+}
+
+// Pictures provides each of the pictures attached within the v2 tag
+func (f *File) Pictures() []*Picture {
+	return f.V2.Pictures()
+}
+
+// SetPicture will attach the picture to the v2 tag, replacing any it must be unique
+// against, to be written by Save
+func (f *File) SetPicture(p *Picture) error {
+	if f.V2 == nil {
+		return fmt.Errorf("no file has been processed")
+	}
+
+	return f.V2.SetPicture(p)
+}
+
+// RemovePicture will remove the pictures of the type with the description from the
+// v2 tag, giving whether any were found
+func (f *File) RemovePicture(t frames.PictureType, description string) bool {
+	if f.V2 == nil {
+		return false
+	}
+
+	return f.V2.RemovePicture(t, description)
+}
@@ -0,0 +1,108 @@
+package id3
+
+import (
+	"testing"
+
+	"github.com/cloudcloud/go-id3/frames"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPictures(t *testing.T) {
+	assert := assert.New(t)
+	img := pngImage(40, 30)
+	apic := "\x00image/jpeg\x00\x03Cover\x00" + string(img)
+	body := "TPE1\x00\x00\x00\x0d\x00\x00\x00Cult of Luna" +
+		"APIC" + string(frames.PutSize(len(apic), 4, 8)) + "\x00\x00" + apic
+	tag := "ID3\x03\x00\x00" + string(frames.PutSize(len(body), 4, 7)) + body
+
+	v, err := parseWith(ParseOptions{}, tag)
+	assert.Nil(err)
+
+	p := v.Pictures()
+	assert.Equal(1, len(p))
+	assert.Equal(frames.PictureCoverFront, p[0].Type)
+	assert.Equal("image/png", p[0].MimeType)
+	assert.Equal("Cover", p[0].Description)
+	assert.Equal(img, p[0].Data)
+	assert.Equal(40, p[0].Width)
+	assert.Equal(30, p[0].Height)
+	assert.Equal(v.Frames[1], p[0].Frame)
+
+	f := &File{V2: v}
+	assert.Equal(p, f.Pictures())
+}
+
+func TestSetPicture(t *testing.T) {
+	assert := assert.New(t)
+	v := &V2{Major: frames.Version3}
+
+	assert.Nil(v.SetPicture(&Picture{Type: 3, Description: "Cover", Data: pngImage(4, 4)}))
+	assert.Nil(v.SetPicture(&Picture{Type: 4, Description: "Back", MimeType: "image/jpeg", Data: []byte{1, 2}}))
+	assert.Nil(v.SetPicture(&Picture{Type: frames.PictureOtherFileIcon, Description: "Icon", Data: pngImage(8, 8)}))
+	assert.Equal(3, len(v.Pictures()))
+
+	a := v.Frames[0].(*frames.APIC)
+	assert.Equal("image/png", a.MimeType)
+	assert.Equal(frames.PictureCoverFront, a.PictureType)
+	assert.Equal("Cover (front)", a.PictureLabel)
+	assert.Equal(byte(frames.EncodingLatin1), a.Encoding)
+
+	// descriptions are unique, as is the icon type
+	assert.Nil(v.SetPicture(&Picture{Type: 0, Description: "Cover", Data: pngImage(2, 2)}))
+	assert.Nil(v.SetPicture(&Picture{Type: frames.PictureOtherFileIcon, Description: "Other", Data: pngImage(8, 8)}))
+	p := v.Pictures()
+	assert.Equal(3, len(p))
+	assert.Equal("Back", p[0].Description)
+	assert.Equal(frames.PictureOther, p[1].Type)
+	assert.Equal("Cover", p[1].Description)
+	assert.Equal("Other", p[2].Description)
+
+	assert.Nil(v.SetPicture(&Picture{Type: 5, Description: "Livret ☺", Data: pngImage(2, 2)}))
+	assert.Equal(byte(frames.EncodingUTF16), v.Frames[3].(*frames.APIC).Encoding)
+
+	_, err := v.Marshal()
+	assert.Nil(err)
+}
+
+func TestSetPictureInvalid(t *testing.T) {
+	assert := assert.New(t)
+	v := &V2{}
+
+	assert.NotNil(v.SetPicture(&Picture{Type: 21, Data: pngImage(2, 2)}))
+	assert.NotNil(v.SetPicture(&Picture{Type: 3, Data: []byte("unknown")}))
+	assert.NotNil(v.SetPicture(&Picture{Type: frames.PictureFileIcon, Data: pngImage(16, 16)}))
+	assert.Empty(v.Frames)
+
+	assert.Nil(v.SetPicture(&Picture{Type: frames.PictureFileIcon, Data: pngImage(32, 32)}))
+	assert.Equal(frames.Version4, v.Frames[0].GetBase().Version)
+
+	f := &File{}
+	assert.NotNil(f.SetPicture(&Picture{Type: 3, Data: pngImage(2, 2)}))
+	assert.False(f.RemovePicture(3, ""))
+}
+
+func TestSetPictureV22(t *testing.T) {
+	assert := assert.New(t)
+	v := &V2{Major: frames.Version2}
+
+	assert.Nil(v.SetPicture(&Picture{Type: 3, Description: "Cover", Data: []byte("\xff\xd8\xff\xe0")}))
+	p, ok := v.Frames[0].(*frames.PIC)
+	assert.True(ok)
+	assert.Equal("\x00JPG\x03Cover\x00\xff\xd8\xff\xe0", string(p.Marshal()))
+}
+
+func TestRemovePicture(t *testing.T) {
+	assert := assert.New(t)
+	f := &File{V2: &V2{Major: frames.Version4}}
+
+	assert.Nil(f.SetPicture(&Picture{Type: 3, Description: "Cover", Data: pngImage(2, 2)}))
+	assert.Nil(f.SetPicture(&Picture{Type: 4, Description: "Back", Data: pngImage(2, 2)}))
+
+	assert.False(f.RemovePicture(4, "Cover"))
+	assert.True(f.RemovePicture(3, "Cover"))
+	assert.False(f.RemovePicture(3, "Cover"))
+
+	p := f.Pictures()
+	assert.Equal(1, len(p))
+	assert.Equal("Back", p[0].Description)
+}
@@ -0,0 +1,119 @@
+package id3
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/cloudcloud/go-id3/frames"
+)
+
+// Restrictions are the limits a v2.4 tag declares it was written within, held in
+// the extended header as a single byte of packed values
+type Restrictions struct {
+	TagSize       byte `json:"tag_size" yaml:"tag_size"`
+	TextEncoding  bool `json:"text_encoding" yaml:"text_encoding"`
+	TextSize      byte `json:"text_size" yaml:"text_size"`
+	ImageEncoding bool `json:"image_encoding" yaml:"image_encoding"`
+	ImageSize     byte `json:"image_size" yaml:"image_size"`
+}
+
+var (
+	restrictedFrames   = []int{128, 64, 32, 32}
+	restrictedTagSize  = []int{1 << 20, 128 << 10, 40 << 10, 4 << 10}
+	restrictedTextSize = []int{0, 1024, 128, 30}
+	restrictedImageDim = []int{0, 256, 64, 64}
+)
+
+// NewRestrictions will decode the packed restrictions byte, laid out as %ppqrrstt
+func NewRestrictions(b byte) *Restrictions {
+	return &Restrictions{
+		TagSize:       b >> 6,
+		TextEncoding:  frames.GetBoolBit(b, 5),
+		TextSize:      (b >> 3) & 0x03,
+		ImageEncoding: frames.GetBoolBit(b, 2),
+		ImageSize:     b & 0x03,
+	}
+}
+
+// Byte will pack the restrictions into their single byte form
+func (r *Restrictions) Byte() byte {
+	b := (r.TagSize&0x03)<<6 | (r.TextSize&0x03)<<3 | r.ImageSize&0x03
+	b = frames.PutBool(b, 5, r.TextEncoding)
+
+	return frames.PutBool(b, 2, r.ImageEncoding)
+}
+
+// MaxFrames provides the largest number of frames the tag may hold
+func (r *Restrictions) MaxFrames() int {
+	return restrictedFrames[r.TagSize&0x03]
+}
+
+// MaxTagSize provides the largest number of bytes the tag may occupy
+func (r *Restrictions) MaxTagSize() int {
+	return restrictedTagSize[r.TagSize&0x03]
+}
+
+// MaxTextSize provides the largest number of characters in a text field, with zero
+// being no limit at all
+func (r *Restrictions) MaxTextSize() int {
+	return restrictedTextSize[r.TextSize&0x03]
+}
+
+// MaxImageSize provides the largest width and height in pixels of an image, with zero
+// being no limit at all. Images are to be exactly this size when ExactImageSize holds.
+func (r *Restrictions) MaxImageSize() int {
+	return restrictedImageDim[r.ImageSize&0x03]
+}
+
+// ExactImageSize determines if images are to be exactly the largest size allowed
+func (r *Restrictions) ExactImageSize() bool {
+	return r.ImageSize&0x03 == 0x03
+}
+
+// Check will compare the frames and the size of the written tag against the
+// restrictions, providing a warning for each that is not met. Image dimensions are
+// checked for the images they can be read from.
+func (r *Restrictions) Check(fr []frames.IFrame, size int) []error {
+	w := []error{}
+	if len(fr) > r.MaxFrames() {
+		w = append(w, fmt.Errorf("restricted to [%d] frames, found [%d]", r.MaxFrames(), len(fr)))
+	}
+	if size > r.MaxTagSize() {
+		w = append(w, fmt.Errorf("restricted to [%d] bytes, tag is [%d]", r.MaxTagSize(), size))
+	}
+
+	for _, v := range fr {
+		base := v.GetBase()
+		if r.TextEncoding && frames.IsUnicode(base.Encoding) {
+			w = append(w, fmt.Errorf("frame [%s] uses UTF-16 where only ISO-8859-1 and UTF-8 are allowed", base.Name))
+		}
+
+		if t, ok := v.(*frames.TEXT); ok && r.MaxTextSize() > 0 {
+			for _, s := range t.GetValues() {
+				if utf8.RuneCountInString(s) > r.MaxTextSize() {
+					w = append(w, fmt.Errorf("frame [%s] text exceeds [%d] characters", base.Name, r.MaxTextSize()))
+					break
+				}
+			}
+		}
+
+		p, ok := v.(frames.IPicture)
+		if !ok {
+			continue
+		}
+
+		a := p.GetPicture()
+		if r.ImageEncoding && a.MimeType != "image/png" && a.MimeType != "image/jpeg" {
+			w = append(w, fmt.Errorf("frame [%s] image [%s] is not PNG or JPEG", base.Name, a.MimeType))
+		}
+
+		if x, y, ok := ImageDimensions(a.Image); ok && r.MaxImageSize() > 0 {
+			m := r.MaxImageSize()
+			if x > m || y > m || (r.ExactImageSize() && (x != m || y != m)) {
+				w = append(w, fmt.Errorf("frame [%s] image is [%dx%d], restricted to [%dx%d]", base.Name, x, y, m, m))
+			}
+		}
+	}
+
+	return w
+}
@@ -0,0 +1,73 @@
+package id3
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cloudcloud/go-id3/frames"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestrictionsByte(t *testing.T) {
+	assert := assert.New(t)
+	r := NewRestrictions('\x6b')
+
+	assert.Equal(&Restrictions{TagSize: 1, TextEncoding: true, TextSize: 1, ImageSize: 3}, r)
+	assert.Equal(byte('\x6b'), r.Byte())
+	assert.Equal(64, r.MaxFrames())
+	assert.Equal(128*1024, r.MaxTagSize())
+	assert.Equal(1024, r.MaxTextSize())
+	assert.Equal(64, r.MaxImageSize())
+	assert.True(r.ExactImageSize())
+
+	for i := 0; i < 256; i++ {
+		assert.Equal(byte(i), NewRestrictions(byte(i)).Byte())
+	}
+}
+
+func TestRestrictionsCheck(t *testing.T) {
+	assert := assert.New(t)
+	text := frames.NewFrame("TIT2", "Title", frames.Version4).(*frames.TEXT)
+	text.Encoding = frames.EncodingUTF16
+	text.SetValues(strings.Repeat("a", 31))
+
+	pic := frames.NewFrame("APIC", "Attached picture", frames.Version4).(*frames.APIC)
+	pic.MimeType = "image/gif"
+
+	r := &Restrictions{}
+	assert.Empty(r.Check([]frames.IFrame{text, pic}, 100))
+
+	r = &Restrictions{TagSize: 3, TextEncoding: true, TextSize: 3, ImageEncoding: true}
+	assert.Equal(4, len(r.Check([]frames.IFrame{text, pic}, 5000)))
+
+	v := &V2{Major: frames.Version4, Extended: true, Restrictions: r, Frames: []frames.IFrame{text}}
+	_, err := v.Marshal()
+	assert.Nil(err)
+	assert.Equal(2, len(v.WriteWarnings))
+
+	text.Encoding = frames.EncodingUTF8
+	text.SetValues("Short")
+	_, err = v.Marshal()
+	assert.Nil(err)
+	assert.Empty(v.WriteWarnings)
+}
+
+func TestRestrictionsCheckImageSize(t *testing.T) {
+	assert := assert.New(t)
+	pic := frames.NewFrame("APIC", "Attached picture", frames.Version4).(*frames.APIC)
+	pic.MimeType = "image/png"
+	pic.Image = pngImage(100, 50)
+
+	assert.Empty((&Restrictions{ImageSize: 1}).Check([]frames.IFrame{pic}, 100))
+	assert.Equal(1, len((&Restrictions{ImageSize: 2}).Check([]frames.IFrame{pic}, 100)))
+
+	pic.Image = pngImage(64, 32)
+	assert.Empty((&Restrictions{ImageSize: 2}).Check([]frames.IFrame{pic}, 100))
+	assert.Equal(1, len((&Restrictions{ImageSize: 3}).Check([]frames.IFrame{pic}, 100)))
+
+	pic.Image = pngImage(64, 64)
+	assert.Empty((&Restrictions{ImageSize: 3}).Check([]frames.IFrame{pic}, 100))
+
+	pic.Image = []byte("unknown")
+	assert.Empty((&Restrictions{ImageSize: 3}).Check([]frames.IFrame{pic}, 100))
+}
@@ -0,0 +1,99 @@
+package id3
+
+import (
+	"github.com/cloudcloud/go-id3/frames"
+)
+
+// Next will parse the tag that a SEEK frame points to, with the offset measured from
+// the end of this tag. Nil is given when there is no SEEK frame to follow.
+func (f *V2) Next(h frames.FrameFile) (*V2, error) {
+	s, ok := f.GetFrame("SEEK").(*frames.SEEK)
+	if !ok {
+		return nil, nil
+	}
+
+	n := &V2{Debug: f.Debug, Options: f.Options}
+	if err := n.ParseAt(h, f.Offset+int64(f.TagSize()+s.SeekPoint)); err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+// Merge will bring the frames of a later tag into this one using the v2.4 update
+// rules, where frames that must be unique replace their earlier counterpart and
+// all others are added alongside those already present.
+func (f *V2) Merge(u *V2) {
+	for _, fr := range u.Frames {
+		if i := f.frameIndex(frameKey(fr)); i >= 0 {
+			f.Frames[i] = fr
+		} else {
+			f.Frames = append(f.Frames, fr)
+		}
+	}
+}
+
+func (f *V2) frameIndex(k string) int {
+	if len(k) < 1 {
+		return -1
+	}
+
+	for i, v := range f.Frames {
+		if frameKey(v) == k {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// frameKey provides what makes a frame unique within a tag, being the frame name
+// along with any content that is allowed to differ between frames of the same name.
+// An empty key is given for frames that may be repeated freely.
+func frameKey(fr frames.IFrame) string {
+	n := fr.GetName()
+	switch x := fr.(type) {
+	case *frames.TXXX:
+		return n + "\x00" + x.Type
+	case *frames.WXXX:
+		return n + "\x00" + x.Title
+	case *frames.WOAF:
+		// commercial and artist pages may be listed more than once
+		if n == "WCOM" || n == "WOAR" || n == "WCM" || n == "WAR" {
+			return ""
+		}
+	case *frames.COMM:
+		return n + "\x00" + x.Language + x.ContentDescription
+	case *frames.USLT:
+		return n + "\x00" + x.Language + x.Descriptor
+	case *frames.SYLT:
+		return n + "\x00" + x.Language + x.Descriptor
+	case frames.IPicture:
+		return n + "\x00" + x.GetPicture().Title
+	case *frames.GEOB:
+		return n + "\x00" + x.ContentDescription
+	case *frames.UFID:
+		return n + "\x00" + x.Owner
+	case *frames.PRIV:
+		return n + "\x00" + x.Owner + "\x00" + string(x.PrivateData)
+	case *frames.POPM:
+		return n + "\x00" + x.Email
+	case *frames.USER:
+		return n + "\x00" + x.Language
+	case *frames.RVA2:
+		return n + "\x00" + x.Identification
+	case *frames.EQU2:
+		return n + "\x00" + x.Identification
+	case *frames.CHAP:
+		return n + "\x00" + x.ElementID
+	case *frames.CTOC:
+		return n + "\x00" + x.ElementID
+	case *frames.TEXT, *frames.MCDI, *frames.ETCO, *frames.MLLT, *frames.SYTC, *frames.RVRB,
+		*frames.PCNT, *frames.RBUF, *frames.POSS, *frames.OWNE, *frames.SEEK, *frames.ASPI,
+		*frames.EQUA, *frames.RVAD, *frames.IPLS:
+	default:
+		return ""
+	}
+
+	return n
+}
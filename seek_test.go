@@ -0,0 +1,81 @@
+package id3
+
+import (
+	"os"
+	"testing"
+
+	"github.com/cloudcloud/go-id3/frames"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	seekingTag = "ID3\x04\x00\x00\x00\x00\x00\x33" +
+		"TPE1\x00\x00\x00\x0d\x00\x00\x00Cult of Luna" +
+		"TIT2\x00\x00\x00\x04\x00\x00\x00Old" +
+		"SEEK\x00\x00\x00\x04\x00\x00\x00\x00\x00\x05"
+	updateTag = "ID3\x04\x00\x00\x00\x00\x00\x26" +
+		"TIT2\x00\x00\x00\x04\x00\x00\x00New" +
+		"COMM\x00\x00\x00\x0e\x00\x00\x00engDesc\x00Hello"
+)
+
+func TestFollowSeek(t *testing.T) {
+	assert := assert.New(t)
+	name := writeTemp(t, seekingTag+"audio"+updateTag+"more audio")
+
+	f := processTemp(t, name)
+	assert.Equal(1, len(f.Tags))
+	assert.Equal("Old", f.GetTitle())
+
+	h, err := os.Open(name)
+	assert.Nil(err)
+	defer func() { _ = h.Close() }()
+
+	f, err = (&File{Filename: name, FollowSeek: true}).Process(h)
+	assert.Nil(err)
+	assert.Equal(2, len(f.Tags))
+	assert.Equal(int64(len(seekingTag)+5), f.Tags[1].Offset)
+	assert.Equal("Old", f.Tags[0].GetTitle())
+	assert.Equal(3, len(f.Tags[0].Frames))
+
+	assert.Equal("New", f.GetTitle())
+	assert.Equal("Cult of Luna", f.GetArtist())
+	assert.Equal(4, len(f.V2.Frames))
+	assert.NotNil(f.V2.GetFrame("COMM"))
+}
+
+func TestSaveFollowedSeek(t *testing.T) {
+	assert := assert.New(t)
+	content := seekingTag + "audio" + updateTag + "more audio"
+	name := writeTemp(t, content)
+
+	h, err := os.OpenFile(name, os.O_RDWR, 0)
+	assert.Nil(err)
+	defer func() { _ = h.Close() }()
+
+	f, err := (&File{Filename: name, FollowSeek: true}).Process(h)
+	assert.Nil(err)
+	f.V2.GetFrame("TIT2").(*frames.TEXT).SetValues("Edited")
+	assert.NotNil(f.Save())
+
+	b, _ := os.ReadFile(name)
+	assert.Equal(content, string(b))
+}
+
+func TestMerge(t *testing.T) {
+	assert := assert.New(t)
+	comm := func(d, c string) frames.IFrame {
+		x := frames.NewFrame("COMM", "Comments", frames.Version4).(*frames.COMM)
+		x.Language = "eng"
+		x.ContentDescription = d
+		x.Comment = c
+		return x
+	}
+	woar := frames.NewFrame("WOAR", "Official artist/performer webpage", frames.Version4)
+
+	v := &V2{Frames: []frames.IFrame{comm("a", "first"), woar}}
+	v.Merge(&V2{Frames: []frames.IFrame{comm("a", "second"), comm("b", "third"), woar}})
+
+	assert.Equal(4, len(v.Frames))
+	assert.Equal("second", v.Frames[0].(*frames.COMM).Comment)
+	assert.Equal("third", v.Frames[2].(*frames.COMM).Comment)
+}
@@ -2,12 +2,15 @@ package id3
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/cloudcloud/go-id3/frames"
 )
 
 // V1 is a structure for Version 1 ID3 content
 type V1 struct {
+	Major   int    `json:"major_version"`
+	Min     int    `json:"min_version"`
 	Artist  string `json:"artist"`
 	Title   string `json:"title"`
 	Album   string `json:"album"`
@@ -20,16 +23,18 @@ type V1 struct {
 }
 
 const (
-	v1TagSize     = 128 // full number of bytes
-	v1TagStart    = 3   // offset where tag content begins
-	v1TitleEnd    = 33  // offset for track title
-	v1ArtistEnd   = 63  // offset for artist name
-	v1AlbumEnd    = 93  // offset for album name
-	v1YearEnd     = 97  // offset where year completes
-	v1CommentEnd  = 125 // offset where comment completes
-	v1TagLocation = 2   // direction from which content is read
-	v1StrLength   = 30  // base string length
-	v1ComLength   = 28  // base comment length
+	v1TagSize     = 128   // full number of bytes
+	v1TagStart    = 3     // offset where tag content begins
+	v1TagInit     = "TAG" // marker for the start of the tag
+	v1TitleEnd    = 33    // offset for track title
+	v1ArtistEnd   = 63    // offset for artist name
+	v1AlbumEnd    = 93    // offset for album name
+	v1YearEnd     = 97    // offset where year completes
+	v1CommentEnd  = 125   // offset where comment completes
+	v1TagLocation = 2     // direction from which content is read
+	v1StrLength   = 30    // base string length
+	v1ComLength   = 28    // base comment length
+	v1YearLength  = 4     // year is always 4 characters
 )
 
 // Parse completes the actual processing of the file
@@ -39,24 +44,108 @@ func (i *V1) Parse(h frames.FrameFile) error {
 	_, _ = h.Seek(-int64(v1TagSize), v1TagLocation)
 	_, _ = h.Read(b)
 
-	if frames.GetStr(b[0:v1TagStart]) != "TAG" {
-		return fmt.Errorf("no id3v1, %#v", b[0:v1TagStart])
+	if frames.GetStr(b[0:v1TagStart]) != v1TagInit {
+		return ErrNoTag
 	}
-	b = b[v1TagStart:]
+	i.Major = 1
 
-	i.Title = frames.GetStr(b[:v1StrLength])
-	b = b[v1StrLength:]
-	i.Artist = frames.GetStr(b[:v1StrLength])
-	b = b[v1StrLength:]
-	i.Album = frames.GetStr(b[:v1StrLength])
-	b = b[v1StrLength:]
-	i.Year = frames.GetInt(b[:4])
-	b = b[4:]
-	i.Comment = frames.GetStr(b[:v1ComLength])
-	b = b[v1ComLength:]
+	i.Title = frames.GetLatin1(b[v1TagStart:v1TitleEnd])
+	i.Artist = frames.GetLatin1(b[v1TitleEnd:v1ArtistEnd])
+	i.Album = frames.GetLatin1(b[v1ArtistEnd:v1AlbumEnd])
+	i.Year = frames.GetInt(b[v1AlbumEnd:v1YearEnd])
 
-	i.Track = frames.GetInt(b[0:2])
-	i.Genre = frames.GetInt([]byte{b[2]})
+	// v1.1 steals the final two comment bytes for a null and a binary track number
+	comment := b[v1YearEnd : v1YearEnd+v1StrLength]
+	if comment[v1ComLength] == '\x00' && comment[v1ComLength+1] != '\x00' {
+		i.Min = 1
+		i.Track = int(comment[v1ComLength+1])
+		comment = comment[:v1ComLength]
+	}
+	i.Comment = frames.GetLatin1(comment)
+	i.Genre = int(b[v1TagSize-1])
 
 	return nil
 }
+
+// Marshal will serialise the tag into its 128 bytes, as v1.1 when a track is present.
+// Fields are encoded as Latin-1 and truncated to fit.
+func (i *V1) Marshal() []byte {
+	b := make([]byte, 0, v1TagSize)
+	b = append(b, v1TagInit...)
+	b = append(b, putV1Str(i.Title, v1StrLength)...)
+	b = append(b, putV1Str(i.Artist, v1StrLength)...)
+	b = append(b, putV1Str(i.Album, v1StrLength)...)
+
+	year := make([]byte, v1YearLength)
+	if i.Year > 0 {
+		year = []byte(fmt.Sprintf("%04d", i.Year%10000))
+	}
+	b = append(b, year...)
+
+	if i.Track > 0 && i.Track < 256 {
+		b = append(b, putV1Str(i.Comment, v1ComLength)...)
+		b = append(b, '\x00', byte(i.Track))
+	} else {
+		b = append(b, putV1Str(i.Comment, v1StrLength)...)
+	}
+
+	return append(b, byte(i.Genre))
+}
+
+// Save will write the tag to the end of the file, replacing an existing tag if
+// one is present or appending otherwise.
+func (i *V1) Save(h frames.FrameFile) error {
+	size, err := h.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+
+	if size >= v1TagSize {
+		b := make([]byte, v1TagStart)
+		if _, err = h.Seek(-int64(v1TagSize), io.SeekEnd); err != nil {
+			return err
+		}
+		if _, err = io.ReadFull(h, b); err != nil {
+			return err
+		}
+
+		if string(b) == v1TagInit {
+			size -= v1TagSize
+		}
+	}
+
+	if _, err = h.Seek(size, io.SeekStart); err != nil {
+		return err
+	}
+	if _, err = h.Write(i.Marshal()); err != nil {
+		return err
+	}
+
+	i.Major = 1
+	i.Min = 0
+	if i.Track > 0 && i.Track < 256 {
+		i.Min = 1
+	}
+
+	return nil
+}
+
+// GetGenre provides the name of the genre, or an empty string when unknown
+func (i *V1) GetGenre() string {
+	return GenreName(i.Genre)
+}
+
+// SetGenre will set the genre from a name, falling back to none when the name
+// is not within the genre list
+func (i *V1) SetGenre(n string) {
+	i.Genre = GenreIndex(n)
+}
+
+func putV1Str(s string, l int) []byte {
+	b := frames.PutLatin1(s)
+	if len(b) > l {
+		b = b[:l]
+	}
+
+	return append(b, make([]byte, l-len(b))...)
+}
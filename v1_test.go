@@ -1,6 +1,10 @@
 package id3
 
-import "testing"
+import (
+	"os"
+	"strings"
+	"testing"
+)
 
 func TestParseV1(t *testing.T) {
 	b := &tfile{}
@@ -9,7 +13,7 @@ func TestParseV1(t *testing.T) {
 		"Bobbum                        " +
 		"2016" +
 		"This is just a comment here " +
-		"01\x01"))
+		"\x00\x01\x01"))
 	v := &V1{Debug: false}
 
 	err := v.Parse(b)
@@ -31,3 +35,108 @@ func TestParseV1Fail(t *testing.T) {
 		t.Fatalf("Incorrectly Parse() the V1 instead of Fail")
 	}
 }
+
+func TestParseV10(t *testing.T) {
+	b := &tfile{}
+	_, _ = b.Write([]byte("TAGBob is great                  " +
+		"Bob                           " +
+		"Bobbum                        " +
+		"2016" +
+		"This is just a comment here 01" +
+		"\x11"))
+	v := &V1{}
+
+	if err := v.Parse(b); err != nil {
+		t.Fatalf("Unable to Parse() in V1, [%s]", err)
+	}
+	if v.Min != 0 || v.Track != 0 {
+		t.Fatalf("Expected v1.0 without a track, got [1.%d] track [%d]", v.Min, v.Track)
+	}
+	if v.Comment != "This is just a comment here 01" {
+		t.Fatalf("Invalid Comment found for V1, [%s]", v.Comment)
+	}
+	if v.Genre != 17 {
+		t.Fatalf("Invalid Genre found for V1, [%d]", v.Genre)
+	}
+}
+
+func TestMarshalV1(t *testing.T) {
+	v := &V1{
+		Title:   "A title that is far too long for the tag",
+		Artist:  "Björk",
+		Album:   "Homogenic",
+		Year:    1997,
+		Comment: "A comment that is also far too long",
+		Track:   3,
+		Genre:   52,
+	}
+
+	b := v.Marshal()
+	if len(b) != v1TagSize {
+		t.Fatalf("Got [%d] bytes, Expected [%d]", len(b), v1TagSize)
+	}
+
+	expected := "TAGA title that is far too long fBj\xf6rk\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00" +
+		"Homogenic\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00" +
+		"1997A comment that is also far t\x00\x03\x34"
+	if string(b) != expected {
+		t.Fatalf("Got [%#v], Expected [%#v]", string(b), expected)
+	}
+
+	v.Track = 0
+	b = v.Marshal()
+	if string(b[v1YearEnd:v1TagSize-1]) != "A comment that is also far too" {
+		t.Fatalf("Expected a v1.0 comment, got [%s]", b[v1YearEnd:v1TagSize-1])
+	}
+}
+
+func TestSaveV1(t *testing.T) {
+	audio := "\xff\xfb\x90\x00audio content"
+	name := writeTemp(t, audio)
+	h, err := os.OpenFile(name, os.O_RDWR, 0)
+	if err != nil {
+		t.Fatalf("Unable to open file, [%s]", err)
+	}
+	defer h.Close()
+
+	v := &V1{Title: "Bob is great", Track: 1}
+	if err = v.Save(h); err != nil {
+		t.Fatalf("Unable to Save(), [%s]", err)
+	}
+	if v.Major != 1 || v.Min != 1 {
+		t.Fatalf("Expected v1.1, got [%d.%d]", v.Major, v.Min)
+	}
+
+	v.Title = "Bob is still great"
+	if err = v.Save(h); err != nil {
+		t.Fatalf("Unable to Save(), [%s]", err)
+	}
+
+	b, _ := os.ReadFile(name)
+	if len(b) != len(audio)+v1TagSize || string(b[:len(audio)]) != audio {
+		t.Fatalf("Expected the tag to be replaced, got [%#v]", b)
+	}
+
+	x := &V1{}
+	if err = x.Parse(h); err != nil {
+		t.Fatalf("Unable to Parse() in V1, [%s]", err)
+	}
+	if x.Title != "Bob is still great" || x.Track != 1 {
+		t.Fatalf("Got [%s] track [%d]", x.Title, x.Track)
+	}
+}
+
+func TestParseV1Latin1(t *testing.T) {
+	pad := func(s string) string { return s + strings.Repeat(" ", v1StrLength-len(s)) }
+	b := &tfile{}
+	_, _ = b.Write([]byte("TAG" + pad("J\xf3hann J\xf3hannsson") + pad("Bj\xf6rk") + pad("Caf\xe9") +
+		"2016" + pad("")[:v1ComLength] + "\x00\x01\x01"))
+	v := &V1{}
+
+	if err := v.Parse(b); err != nil {
+		t.Fatalf("Unable to Parse() in V1, [%s]", err)
+	}
+	if v.Title != "Jóhann Jóhannsson" || v.Artist != "Björk" || v.Album != "Café" {
+		t.Fatalf("Got [%s] [%s] [%s]", v.Title, v.Artist, v.Album)
+	}
+}
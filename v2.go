@@ -1,9 +1,11 @@
 package id3
 
 import (
+	"bytes"
 	"fmt"
+	"hash/crc32"
 	"io"
-	"os"
+	"strings"
 
 	"github.com/cloudcloud/go-id3/frames"
 )
@@ -20,16 +22,39 @@ type V2 struct {
 	Extended       bool            `json:"extended"`
 	Experimental   bool            `json:"experimental"`
 	Footer         bool            `json:"footer"`
+	Padding        int             `json:"padding"`
+	Offset         int64           `json:"offset"`
 
 	ExtendedSize    int    `json:"extended_size" yaml:"extended_size"`
 	ExtendedFlag    []byte `json:"extended_flag" yaml:"extended_flag"`
 	ExtendedPadding int    `json:"extended_padding" yaml:"extended_padding"`
 	Crc             bool   `json:"crc"`
 	CrcContent      []byte `json:"crc_content" yaml:"crc_content"`
-
-	Debug  bool `json:"-"`
-	file   frames.FrameFile
-	offset int
+	CRC             uint32 `json:"crc_value" yaml:"crc_value"`
+	CrcValid        bool   `json:"crc_valid" yaml:"crc_valid"`
+
+	IsUpdate     bool          `json:"is_update" yaml:"is_update"`
+	Restrictions *Restrictions `json:"restrictions"`
+
+	// WriteWarnings lists each restriction the tag did not meet when last written
+	WriteWarnings []error `json:"-" yaml:"-"`
+
+	// CompressThreshold opts into compressing large APIC, GEOB and PRIV frames when
+	// writing, once their content reaches this many bytes. Zero leaves them as is.
+	CompressThreshold int `json:"-" yaml:"-"`
+
+	// Options decide how tolerant parsing is of problems with the tag
+	Options ParseOptions `json:"-" yaml:"-"`
+	// Warnings lists the problems that parsing recovered from
+	Warnings []error `json:"-" yaml:"-"`
+
+	Debug     bool `json:"-"`
+	file      io.Reader
+	offset    int
+	limit     int
+	crcData   []byte
+	err       error
+	synchsafe bool
 }
 
 const (
@@ -51,15 +76,33 @@ const (
 	v2OffsetMinor = 4
 	v2OffsetFlag  = 5
 	v2OffsetSize  = 6
+
+	v2ExtendedCrcBit      = 7 // v2.3 extended flag bit for CRC data
+	v2ExtendedUpdateBit   = 6 // v2.4 extended flag bits, each followed by its data
+	v2ExtendedCrc4Bit     = 5
+	v2ExtendedRestrictBit = 4
+	v2ExtendedCrcLength   = 5 // v2.4 CRC is held as a 35 bit synchsafe integer
+
+	v2FooterInit     = "3DI" // footer mirrors the header with a reversed marker
+	v2MaxSize        = 1<<28 - 1
+	v2DefaultPadding = 1024 // padding given to rebuilt tags for future in place edits
 )
 
+// v2Compressible lists the frames that tend to hold enough binary content to be
+// worth compressing
+var v2Compressible = map[string]bool{
+	"APIC": true,
+	"GEOB": true,
+	"PRIV": true,
+}
+
 func getBuffer(f frames.FrameFile) ([]byte, error) {
 	buf := make([]byte, v2HeaderLength)
 	_, _ = f.Seek(v2HeaderStart, v2HeaderStart)
 	_, _ = f.Read(buf)
 
 	if string(buf[:v2HeaderOffset]) != v2HeaderInit {
-		return nil, fmt.Errorf("not a valid ID3 Version 2 tag found")
+		return nil, ErrNoTag
 	}
 
 	return buf, nil
@@ -68,12 +111,26 @@ func getBuffer(f frames.FrameFile) ([]byte, error) {
 func (f *V2) primeHeaderFromFile(h frames.FrameFile) error {
 	f.file = h
 	f.Frames = []frames.IFrame{}
+	f.Warnings = nil
 
 	buf, err := getBuffer(h)
 	if err != nil {
-		return err
+		// a tag may instead be appended to the end of the file
+		o := findAppended(h)
+		if o < 0 {
+			return err
+		}
+
+		buf = readAt(h, o, v2HeaderLength)
+		f.Offset = o
 	}
 
+	f.primeHeader(buf)
+
+	return nil
+}
+
+func (f *V2) primeHeader(buf []byte) {
 	f.Major = frames.GetDirectInt(buf[v2OffsetMajor])
 	f.Min = frames.GetDirectInt(buf[v2OffsetMinor])
 	f.Flag = buf[v2OffsetFlag]
@@ -84,106 +141,529 @@ func (f *V2) primeHeaderFromFile(h frames.FrameFile) error {
 	f.Footer = frames.GetBoolBit(f.Flag, v2FooterBit)
 
 	f.Size = frames.GetSize(buf[v2OffsetSize:], bitwiseSeventhShifter)
-
-	return nil
+	f.synchsafe = frames.IsSynchsafe(buf[v2OffsetSize:])
+	f.limit = f.Size
 }
 
-func (f *V2) primeExtended() {
+func (f *V2) primeExtended() error {
 	if !f.Extended {
-		return
+		return nil
+	}
+
+	if f.Major == frames.Version4 {
+		f.primeExtended24()
+	} else {
+		f.primeExtended23()
 	}
 
+	if f.offset > f.limit || f.err != nil {
+		return ErrTruncated
+	}
+
+	return nil
+}
+
+func (f *V2) primeExtended23() {
 	extended := f.nextBytes(v2HeaderLength)
+	if len(extended) < v2HeaderLength {
+		return
+	}
 
 	f.ExtendedSize = frames.GetSize(extended[:4], 8)
 	f.ExtendedFlag = extended[4:6]
 	f.ExtendedPadding = frames.GetSize(extended[6:], 8)
 
-	f.Crc = frames.GetBoolBit(extended[4], 7)
+	f.Crc = frames.GetBoolBit(extended[4], v2ExtendedCrcBit)
 	if f.Crc {
 		f.CrcContent = f.nextBytes(4)
+		f.CRC = uint32(frames.GetSize(f.CrcContent, bitwiseEighthShifter))
 	}
 }
 
+// primeExtended24 reads the v2.4 extended header, where the synchsafe size includes
+// itself and each flag that is set is followed by its own length prefixed data
+func (f *V2) primeExtended24() {
+	f.ExtendedSize = frames.GetSize(f.nextBytes(v2NewByteLen), bitwiseSeventhShifter)
+	if f.ExtendedSize <= v2NewByteLen {
+		return
+	}
+
+	d := f.nextBytes(f.ExtendedSize - v2NewByteLen)
+	if len(d) < 2 || int(d[0]) < 1 || len(d) < 1+int(d[0]) {
+		return
+	}
+
+	f.ExtendedFlag = d[1 : 1+int(d[0])]
+	d = d[1+int(d[0]):]
+
+	flags := f.ExtendedFlag[0]
+	data := func() []byte {
+		if len(d) < 1 || len(d) < 1+int(d[0]) {
+			d = []byte{}
+			return d
+		}
+
+		b := d[1 : 1+int(d[0])]
+		d = d[1+int(d[0]):]
+		return b
+	}
+
+	if f.IsUpdate = frames.GetBoolBit(flags, v2ExtendedUpdateBit); f.IsUpdate {
+		data()
+	}
+
+	if f.Crc = frames.GetBoolBit(flags, v2ExtendedCrc4Bit); f.Crc {
+		f.CrcContent = data()
+		f.CRC = uint32(frames.GetSize(f.CrcContent, bitwiseSeventhShifter))
+	}
+
+	if frames.GetBoolBit(flags, v2ExtendedRestrictBit) {
+		if b := data(); len(b) > 0 {
+			f.Restrictions = NewRestrictions(b[0])
+		}
+	}
+}
+
+// putExtended will provide the extended header in the layout of the current version,
+// including the CRC of the given data when Crc is set
+func (f *V2) putExtended(crc []byte) []byte {
+	if f.Major == frames.Version3 {
+		size := 6
+		if f.Crc {
+			size += 4
+		}
+
+		out := frames.PutSize(size, v2NewByteLen, bitwiseEighthShifter)
+		out = append(out, frames.PutBool(0, v2ExtendedCrcBit, f.Crc), 0)
+		out = append(out, frames.PutSize(f.Padding, v2NewByteLen, bitwiseEighthShifter)...)
+		if f.Crc {
+			out = append(out, frames.PutSize(int(crc32.ChecksumIEEE(crc)), v2NewByteLen, bitwiseEighthShifter)...)
+		}
+
+		return out
+	}
+
+	flags := frames.PutBool(0, v2ExtendedUpdateBit, f.IsUpdate)
+	flags = frames.PutBool(flags, v2ExtendedCrc4Bit, f.Crc)
+	flags = frames.PutBool(flags, v2ExtendedRestrictBit, f.Restrictions != nil)
+
+	d := []byte{1, flags}
+	if f.IsUpdate {
+		d = append(d, 0)
+	}
+	if f.Crc {
+		d = append(d, v2ExtendedCrcLength)
+		d = append(d, frames.PutSize(int(crc32.ChecksumIEEE(crc)), v2ExtendedCrcLength, bitwiseSeventhShifter)...)
+	}
+	if f.Restrictions != nil {
+		d = append(d, 1, f.Restrictions.Byte())
+	}
+
+	return append(frames.PutSize(v2NewByteLen+len(d), v2NewByteLen, bitwiseSeventhShifter), d...)
+}
+
 // Parse will trawl a file handle for frames
 func (f *V2) Parse(h frames.FrameFile) error {
 	if err := f.primeHeaderFromFile(h); err != nil {
 		return err
 	}
 
-	f.primeExtended()
+	return f.parseFrames()
+}
+
+// ParseAt will trawl the tag starting at the offset within the file handle
+func (f *V2) ParseAt(h frames.FrameFile, o int64) error {
+	f.file = h
+	f.Frames = []frames.IFrame{}
+	f.Warnings = nil
+
+	buf := readAt(h, o, v2HeaderLength)
+	if string(buf[:v2HeaderOffset]) != v2HeaderInit {
+		return ErrNoTag
+	}
+
+	f.Offset = o
+	f.primeHeader(buf)
+
+	return f.parseFrames()
+}
+
+// parseFrames will check the header before trawling the frames, following the
+// parse mode for any problems found. Lenient parsing keeps whatever frames were read
+// before a problem with the tag structure, listing it as a warning.
+func (f *V2) parseFrames() error {
+	if f.Major < frames.Version2 || f.Major > frames.Version4 {
+		return f.parseError(0, "", ErrUnsupportedVersion)
+	}
+	if f.Options.MaxTagSize > 0 && f.TagSize() > f.Options.MaxTagSize {
+		return f.parseError(0, "", ErrTooLarge)
+	}
+	if !f.synchsafe {
+		if err := f.violation(f.parseError(0, "", ErrNotSynchsafe)); err != nil {
+			return err
+		}
+	}
+
+	err := f.readFrames()
+	if err != nil && f.Options.Mode == ParseLenient {
+		f.Warnings = append(f.Warnings, err)
+		return nil
+	}
+
+	return err
+}
+
+// readFrames will trawl the tag content following the header. Problems with the
+// tag structure stop the parsing, while a frame with content that can't be
+// processed is kept as an unknown frame holding the content as found, with the
+// first of those problems given once all frames have been read.
+func (f *V2) readFrames() error {
+	// v2.4 unsynchronisation is applied per frame rather than across the tag
+	if f.Unsynchronised && f.Major < frames.Version4 {
+		f.desyncTag()
+	}
 
-	// wait for a panic
-	defer f.catcher(os.Stderr)
+	if err := f.primeExtended(); err != nil {
+		return f.parseError(0, "", err)
+	}
+	if f.Crc {
+		f.bufferCrcData()
+	}
 
 	// trawl frames time
+	start := f.offset
+	last := f.offset
+	defer func() {
+		if f.limit > last {
+			f.Padding = f.limit - last
+		}
+		if f.Crc {
+			f.verifyCrc(last - start)
+		}
+	}()
+
+	var first error
 	for {
 		var resp func() frames.IFrame
 		var frame frames.IFrame
+		var flags []byte
+		var err error
 		tmpSize := 0
+		at := f.offset
 
 		switch f.Major {
 		case frames.Version3:
-			tmpSize, resp = f.prepV2Frame(v2NewByteLen, bitwiseEighthShifter, frames.Version23Frames, true)
+			tmpSize, flags, resp, err = f.prepV2Frame(v2NewByteLen, bitwiseEighthShifter, true)
 		case frames.Version4:
-			tmpSize, resp = f.prepV2Frame(v2NewByteLen, bitwiseSeventhShifter, frames.Version24Frames, true)
+			tmpSize, flags, resp, err = f.prepV2Frame(v2NewByteLen, bitwiseSeventhShifter, true)
 		case frames.Version2:
-			tmpSize, resp = f.prepV2Frame(v2OrigByteLen, bitwiseSeventhShifter, frames.Version22Frames, false)
-		default:
-			return fmt.Errorf("frame version not supported v2.%d.%d", f.Major, f.Min)
+			tmpSize, flags, resp, err = f.prepV2Frame(v2OrigByteLen, bitwiseSeventhShifter, false)
+		}
+		if err != nil {
+			return err
 		}
 
-		// lack of frame or invalid frame
-		if tmpSize == 0 {
+		// invalid frame or lack of frame
+		if tmpSize == -1 {
+			continue
+		} else if resp == nil {
 			break
-		} else if tmpSize == -1 {
+		}
+
+		frame = resp()
+		if tmpSize > f.limit-f.offset {
+			return f.parseError(at, frame.GetName(), ErrTruncated)
+		}
+
+		if f.Options.MaxFrameSize > 0 && tmpSize > f.Options.MaxFrameSize {
+			if err := f.violation(f.parseError(at, frame.GetName(), ErrTooLarge)); err != nil {
+				return err
+			}
+
+			f.skipBytes(tmpSize)
+			last = f.offset
 			continue
 		}
 
 		tmpFrame := f.nextBytes(tmpSize)
-		frame = resp()
+		if f.err != nil {
+			return f.parseError(at, frame.GetName(), f.err)
+		}
+
+		base := frame.GetBase()
+		base.ProcessFlags(f.Major, flags)
+		if _, ok := frame.(*frames.UNKNOWN); !ok && base.Encryption {
+			// encrypted content can't be decoded, so it is kept as it was found
+			frame = frames.NewUnknown(frame.GetName(), f.Major)
+			base = frame.GetBase()
+			base.ProcessFlags(f.Major, flags)
+		}
+		if f.Major == frames.Version4 && f.Unsynchronised {
+			base.Unsynchronisation = true
+		}
+		raw := tmpFrame
+		if _, ok := frame.(*frames.UNKNOWN); !ok {
+			tmpFrame, err = base.ProcessHeaderData(f.Major, tmpFrame, f.Options.MaxFrameSize)
+		}
+
 		if f.Debug {
 			fmt.Printf("Pushing in [%s]\n", frame.GetName())
 		}
-		f.Frames = append(f.Frames, frame.ProcessData(tmpSize, tmpFrame))
+		last = f.offset
+		if err == nil {
+			_, err = frames.Process(frame, len(tmpFrame), tmpFrame)
+		}
+		if err != nil {
+			err = f.parseError(at, frame.GetName(), err)
+			if f.Options.Mode == ParseDefault {
+				if first == nil {
+					first = err
+				}
+			} else if err = f.violation(err); err != nil {
+				return err
+			}
+
+			// content that can't be decoded is kept as it was found, so that saving
+			// doesn't lose it
+			frame = frames.NewUnknown(frame.GetName(), f.Major)
+			base = frame.GetBase()
+			base.ProcessFlags(f.Major, flags)
+			if f.Major == frames.Version4 && f.Unsynchronised {
+				base.Unsynchronisation = true
+			}
+			frame.ProcessData(len(raw), raw)
+		}
+
+		f.Frames = append(f.Frames, frame)
 	}
 
-	return nil
+	if f.err != nil && first == nil {
+		return f.parseError(last, "", f.err)
+	}
+
+	return first
+}
+
+// parseError will describe a problem found at the offset within the tag content
+func (f *V2) parseError(at int, name string, err error) error {
+	return &ParseError{
+		Offset:  f.Offset + v2HeaderLength + int64(at),
+		Frame:   name,
+		Version: f.Major,
+		Err:     err,
+	}
 }
 
-func (f *V2) prepV2Frame(l int, s uint, fr map[string]func() frames.IFrame, before bool) (int, func() frames.IFrame) {
+func (f *V2) prepV2Frame(l int, s uint, before bool) (int, []byte, func() frames.IFrame, error) {
+	at := f.offset
 	frameName := f.nextBytes(l)
 	if f.Debug {
 		fmt.Printf("Potential name [%s]\n", frameName)
 	}
 
-	if len(frameName) != l {
-		return 0, nil
+	// padding follows the last frame
+	if len(frameName) != l || frameName[0] == 0 {
+		return 0, nil, nil, nil
 	}
 
-	resp, ok := fr[frames.GetStr(frameName)]
-	detail := []byte{}
-	// soak up the bytes before continuing
+	name := frames.GetStr(frameName)
+	resp, ok := frames.Lookup(name, f.Major)
+
+	// the size follows the name, along with the flags from v2.3 onwards
+	want := l
 	if before {
-		detail = f.nextBytes(v2HeaderLength - l)
+		want = v2HeaderLength - l
+	}
+	detail := f.nextBytes(want)
+
+	// a frame header running past the end of the tag is no frame at all
+	if len(detail) != want {
+		return 0, nil, nil, nil
 	}
 
+	size := frames.GetSize(detail[:l], s)
+	flags := detail[l:]
+	synchsafe := f.Major != frames.Version4 || frames.IsSynchsafe(detail[:l])
+	if !synchsafe {
+		// some writers use plain sizes in v2.4, so the size is read as such
+		size = frames.GetSize(detail[:l], bitwiseEighthShifter)
+	}
+
+	// an invalid frame is passed over in full, so the frames after it can be read
+	if !validFrameID(frameName) {
+		if err := f.violation(f.parseError(at, name, ErrInvalidFrameID)); err != nil {
+			return 0, nil, nil, err
+		}
+
+		f.skipBytes(min(size, f.limit-f.offset))
+		return -1, nil, nil, nil
+	}
+
+	if !synchsafe {
+		if err := f.violation(f.parseError(at, name, ErrNotSynchsafe)); err != nil {
+			return 0, nil, nil, err
+		}
+	}
+
+	// frames without a decoder are kept as they were found
 	if !ok {
-		return -1, nil
+		resp = func() frames.IFrame {
+			return frames.NewUnknown(string(frameName), f.Major)
+		}
 	}
 
-	if !before {
-		detail = f.nextBytes(l)
+	return size, flags, resp, nil
+}
+
+// validFrameID determines if the frame identifier is made only of A-Z and 0-9
+func validFrameID(b []byte) bool {
+	for _, v := range b {
+		if (v < 'A' || v > 'Z') && (v < '0' || v > '9') {
+			return false
+		}
 	}
 
-	size := 0
-	if before {
-		size = frames.GetSize(detail[:l], s)
-	} else {
-		size = frames.GetSize(detail, s)
+	return true
+}
+
+// Marshal will serialise the tag into bytes, with the header, frames and padding
+// in the layout of the current major version. Only v2.3 and v2.4 can be written.
+// Unsynchronisation is applied across the tag for v2.3 and per frame for v2.4.
+// Unknown frames flagged to be discarded on tag alteration are left out, and any v2.4
+// restrictions that are not met are listed in WriteWarnings. When Crc is set the
+// CRC is generated afresh for the extended header.
+func (f *V2) Marshal() ([]byte, error) {
+	var sig uint
+	switch f.Major {
+	case frames.Version3:
+		sig = bitwiseEighthShifter
+	case frames.Version4:
+		sig = bitwiseSeventhShifter
+	default:
+		return nil, fmt.Errorf("writing not supported for v2.%d", f.Major)
 	}
 
-	return size, resp
+	body := []byte{}
+	written := []frames.IFrame{}
+	for _, v := range f.Frames {
+		base := v.GetBase()
+		if len(base.Name) != v2NewByteLen {
+			return nil, fmt.Errorf("frame [%s] is not valid for v2.%d", base.Name, f.Major)
+		}
+
+		// writing the tag is an alteration, so unknown frames asking to be discarded
+		// are dropped. The audio is never altered, so file preservation is kept.
+		u, unknown := v.(*frames.UNKNOWN)
+		if unknown && base.TagPreserve {
+			continue
+		}
+		written = append(written, v)
+
+		// frames are laid out for the tag version, which embedded frames rely upon,
+		// while unknown frames are written as they were found with their own flags
+		if !unknown {
+			base.Version = f.Major
+		}
+
+		d := v.Marshal()
+		flags := base.Flags
+		if !unknown || u.Version != f.Major {
+			if unknown && u.Transformed() {
+				return nil, fmt.Errorf("unknown frame [%s] can't be moved from v2.%d to v2.%d", base.Name, u.Version, f.Major)
+			}
+
+			unsync := f.Unsynchronised && f.Major == frames.Version4
+			flags, d = base.PutFlags(f.Major, unsync, f.compressFrame(base, len(d)), d)
+		}
+
+		body = append(body, base.Name...)
+		body = append(body, frames.PutSize(len(d), v2NewByteLen, sig)...)
+		body = append(body, frames.PutSize(flags, 2, bitwiseEighthShifter)...)
+		body = append(body, d...)
+	}
+
+	// footers and padding are mutually exclusive
+	footer := f.Footer && f.Major == frames.Version4
+	crc := body
+	if !footer && f.Padding > 0 {
+		body = append(body, make([]byte, f.Padding)...)
+	}
+
+	if f.Extended {
+		if f.Major == frames.Version4 {
+			crc = body
+		}
+		body = append(f.putExtended(crc), body...)
+	}
+
+	if f.Unsynchronised && f.Major < frames.Version4 {
+		body = frames.Unsync(body)
+	}
+
+	if len(body) > v2MaxSize {
+		return nil, fmt.Errorf("tag size [%d] exceeds the maximum", len(body))
+	}
+
+	flag := frames.PutBool(0, v2UnsyncBit, f.Unsynchronised)
+	flag = frames.PutBool(flag, v2ExtendedBit, f.Extended)
+	flag = frames.PutBool(flag, v2ExperimentalBit, f.Experimental)
+	flag = frames.PutBool(flag, v2FooterBit, footer)
+	header := []byte{byte(f.Major), byte(f.Min), flag}
+	header = append(header, frames.PutSize(len(body), v2NewByteLen, bitwiseSeventhShifter)...)
+
+	out := append([]byte(v2HeaderInit), header...)
+	out = append(out, body...)
+	if footer {
+		out = append(out, v2FooterInit...)
+		out = append(out, header...)
+	}
+
+	f.WriteWarnings = nil
+	if f.Restrictions != nil && f.Major == frames.Version4 {
+		f.WriteWarnings = f.Restrictions.Check(written, len(out))
+	}
+
+	return out, nil
+}
+
+// compressFrame determines if a frame is to be compressed when written, either
+// as it was read that way or as it is large enough to opt in
+func (f *V2) compressFrame(base *frames.Frame, l int) bool {
+	if base.Encryption {
+		return false
+	}
+	if base.Compression {
+		return true
+	}
+
+	return f.CompressThreshold > 0 && l >= f.CompressThreshold && v2Compressible[base.Name]
+}
+
+// TagSize provides the full number of bytes the tag occupies in the file, including
+// the header and any footer. Zero is given when no tag was found.
+func (f *V2) TagSize() int {
+	if f.Major == 0 {
+		return 0
+	}
+
+	size := v2HeaderLength + f.Size
+	if f.Footer && f.Major == frames.Version4 {
+		size += v2HeaderLength
+	}
+
+	return size
+}
+
+// WriteTo will write the serialised tag to the writer
+func (f *V2) WriteTo(w io.Writer) (int64, error) {
+	b, err := f.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := w.Write(b)
+
+	return int64(n), err
 }
 
 // GetFrame will provide a specific Frame if it exists
@@ -212,25 +692,43 @@ func (f *V2) GetTitle() string {
 	return f.findTextIdx([]string{"TIT2", "TIT3", "TIT1", "TT2", "TT3", "TT1"})
 }
 
-func (f *V2) findTextIdx(i []string) string {
+// GetArtists will retrieve each of the artists from the ideal frame
+func (f *V2) GetArtists() []string {
+	return f.findTextValues([]string{"TPE1", "TPE2", "TPE3", "TPE4", "TP1", "TP2", "TP3", "TP4"})
+}
+
+// GetGenres will resolve the genres from the content type frame
+func (f *V2) GetGenres() []string {
+	return ResolveGenres(strings.Join(f.findTextValues([]string{"TCON", "TCO"}), "\x00"))
+}
+
+// findTextValues gives the values of the first text frame found, passing over frames
+// that aren't text, such as those kept unknown or registered with another type
+func (f *V2) findTextValues(i []string) []string {
 	for _, x := range i {
-		if a := f.GetFrame(x); a != nil {
-			return a.(*frames.TEXT).Cleaned
+		if a, ok := f.GetFrame(x).(*frames.TEXT); ok {
+			return a.GetValues()
 		}
 	}
 
-	return ""
+	return []string{}
 }
 
-func (f *V2) catcher(o io.Writer) {
-	if r := recover(); r != nil {
-		fmt.Fprintf(o, "Stumbled upon a panic(), %s.\n", r)
+func (f *V2) findTextIdx(i []string) string {
+	for _, x := range i {
+		if a, ok := f.GetFrame(x).(*frames.TEXT); ok {
+			return a.Cleaned
+		}
 	}
+
+	return ""
 }
 
+// nextBytes will read the next number of bytes of the tag, giving none once the
+// end of the tag is passed. A file that ends early is recorded as truncated.
 func (f *V2) nextBytes(length int) []byte {
 	if length < 1 {
-		panic("WTF. Please give a positive length for grabbing bytes.")
+		return []byte{}
 	}
 
 	f.offset += length
@@ -238,13 +736,70 @@ func (f *V2) nextBytes(length int) []byte {
 		fmt.Printf("%d vs. %d [total: %d]\n", length, f.offset, f.Size)
 	}
 
-	if f.offset > f.Size {
-		// want to panic to stop, but hopefully not
+	if f.offset > f.limit {
 		return []byte{}
 	}
 
-	t := make([]byte, length)
-	_, _ = f.file.Read(t)
+	t, ok := readLimit(f.file, length)
+	if !ok {
+		f.err = ErrTruncated
+	}
 
 	return t
 }
+
+// readLimit will read up to l bytes, growing the buffer as content arrives rather
+// than trusting a declared length that the file may not hold
+func readLimit(r io.Reader, l int) ([]byte, bool) {
+	b, err := io.ReadAll(io.LimitReader(r, int64(l)))
+
+	return b, err == nil && len(b) == l
+}
+
+// skipBytes will pass over content without holding on to it
+func (f *V2) skipBytes(length int) {
+	f.offset += length
+	if n, err := io.CopyN(io.Discard, f.file, int64(length)); err != nil || n < int64(length) {
+		f.err = ErrTruncated
+	}
+}
+
+// bufferCrcData will read the remainder of the tag into memory, keeping hold of it
+// so the CRC can be verified once the frames have been read
+func (f *V2) bufferCrcData() {
+	if f.limit <= f.offset {
+		return
+	}
+
+	b, ok := readLimit(f.file, f.limit-f.offset)
+	if !ok {
+		f.err = ErrTruncated
+	}
+
+	f.crcData = b
+	f.file = bytes.NewReader(f.crcData)
+}
+
+// verifyCrc will compare the CRC against the data it covers. For v2.3 this is only
+// the frames, while v2.4 includes the padding as well.
+func (f *V2) verifyCrc(l int) {
+	d := f.crcData
+	if f.Major < frames.Version4 && l >= 0 && l <= len(d) {
+		d = d[:l]
+	}
+
+	f.CrcValid = crc32.ChecksumIEEE(d) == f.CRC
+	f.crcData = nil
+}
+
+// desyncTag will read the remainder of the tag, reversing the unsynchronisation
+// so that parsing can continue from memory
+func (f *V2) desyncTag() {
+	b, ok := readLimit(f.file, f.Size)
+	if !ok {
+		f.err = ErrTruncated
+	}
+
+	f.file = bytes.NewReader(frames.Desync(b))
+	f.limit = f.file.(*bytes.Reader).Len()
+}
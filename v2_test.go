@@ -2,7 +2,11 @@ package id3
 
 import (
 	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"hash/crc32"
+	"io"
 	"testing"
 
 	"github.com/cloudcloud/go-id3/frames"
@@ -127,17 +131,6 @@ func TestV2Pe4(t *testing.T) {
 	}
 }
 
-func TestPanicNextBytes(t *testing.T) {
-	defer func() {
-		if r := recover(); r == nil {
-			t.Fatalf("Was expecting a panic() from zero length read")
-		}
-	}()
-
-	v := &V2{}
-	_ = v.nextBytes(0)
-}
-
 func TestParseV2Original(t *testing.T) {
 	b := &tfile{}
 	v := &V2{Debug: false}
@@ -150,11 +143,33 @@ func TestParseV2Original(t *testing.T) {
 		t.Fatalf("Unexpected error: [%s]", err)
 	}
 
-	expected := 1
+	expected := 2
 	found := len(v.Frames)
 	if found != expected {
 		t.Fatalf("Got [%d], Expected [%d]", found, expected)
 	}
+
+	if u, ok := v.Frames[1].(*frames.UNKNOWN); !ok || u.Name != "BUD" {
+		t.Fatalf("Expected [BUD] to be kept as an unknown frame, got [%#v]", v.Frames[1])
+	}
+}
+
+func TestParseV2Picture(t *testing.T) {
+	assert := assert.New(t)
+	b := &tfile{}
+	_, _ = b.Write([]byte("ID3\x02\x00\x00\x00\x00\x00\x14" +
+		"PIC\x00\x00\x0e\x00JPG\x03Cover\x00\xff\xd8\xff"))
+	v := &V2{}
+	assert.Nil(v.Parse(b))
+	assert.Equal(1, len(v.Frames))
+
+	p, ok := v.Frames[0].(frames.IPicture)
+	assert.True(ok)
+	assert.Equal("image/jpeg", p.GetPicture().MimeType)
+	assert.Equal(frames.PictureCoverFront, p.GetPicture().PictureType)
+	assert.Equal("Cover (front)", p.GetPicture().PictureLabel)
+	assert.Equal([]byte("\xff\xd8\xff"), p.GetPicture().Image)
+	assert.Equal("PIC\x00Cover", frameKey(v.Frames[0]))
 }
 
 func TestParseInvalidVersion(t *testing.T) {
@@ -171,37 +186,6 @@ func TestParseInvalidVersion(t *testing.T) {
 	}
 }
 
-func TestSimpleCatcher(t *testing.T) {
-	v := &V2{}
-	b := &tfile{}
-
-	v.catcher(b)
-	found := b.buf.String()
-	expected := "<nil>"
-	if found != expected {
-		t.Errorf("Got [%s], Expected [%s]", found, expected)
-	}
-}
-
-func TestCatcherElegance(t *testing.T) {
-	v := &V2{}
-	b := &tfile{}
-
-	catchMe(v, b)
-
-	found := b.buf.String()
-	expected := "Stumbled upon a panic(), testing.\n"
-	if found != expected {
-		t.Errorf("Got [%s], Expected [%s]", found, expected)
-	}
-}
-
-func catchMe(v *V2, b *tfile) {
-	defer v.catcher(b)
-
-	panic("testing")
-}
-
 func TestGetTitle(t *testing.T) {
 	assert := assert.New(t)
 	x := []frames.IFrame{
@@ -224,3 +208,464 @@ func TestGetTitle(t *testing.T) {
 		assert.Equal(expected, actual, "")
 	}
 }
+
+func TestV2MarshalRoundTrip(t *testing.T) {
+	assert := assert.New(t)
+	tags := []string{
+		"ID3\x03\x00\x00\x00\x00\x00\x47" +
+			"TPE1\x00\x00\x00\x0d\x00\x00\x00Cult of Luna" +
+			"COMM\x00\x00\x00\x0e\x00\x00\x00engDesc\x00Hello" +
+			"APIC\x00\x00\x00\x0a\x00\x00\x00img\x00\x03\x00\x01\x02\x03" +
+			"\x00\x00\x00\x00",
+		"ID3\x04\x00\x10\x00\x00\x00\x25" +
+			"TPE1\x00\x00\x00\x0d\x00\x00\x00Cult of Luna" +
+			"SEEK\x00\x00\x00\x04\x00\x00\x00\x00\x01\x00" +
+			"3DI\x04\x00\x10\x00\x00\x00\x25",
+	}
+
+	for _, tag := range tags {
+		b := &tfile{}
+		_, _ = b.Write([]byte(tag))
+		v := &V2{}
+		assert.Nil(v.Parse(b))
+
+		var o bytes.Buffer
+		n, err := v.WriteTo(&o)
+		assert.Nil(err)
+		assert.Equal(int64(len(tag)), n)
+		assert.Equal([]byte(tag), o.Bytes())
+
+		b = &tfile{}
+		_, _ = b.Write(o.Bytes())
+		x := &V2{}
+		assert.Nil(x.Parse(b))
+		assert.Equal(v.Frames, x.Frames)
+		assert.Equal(v.Padding, x.Padding)
+	}
+}
+
+func TestV2MarshalUnsupported(t *testing.T) {
+	v := &V2{Major: frames.Version2}
+	if _, err := v.Marshal(); err == nil {
+		t.Fatal("Expected an error writing v2.2")
+	}
+
+	v = &V2{Major: frames.Version3, Frames: []frames.IFrame{frames.NewFrame("TT2", "", frames.Version2)}}
+	if _, err := v.Marshal(); err == nil {
+		t.Fatal("Expected an error writing a v2.2 frame")
+	}
+}
+
+func TestGetArtists(t *testing.T) {
+	b := &tfile{}
+	v := &V2{}
+	_, _ = b.Write([]byte("ID3\x04\x00\x00\x00\x00\x00\x1d" +
+		"TPE1\x00\x00\x00\x13\x00\x00\x03Cult of Luna\x00Isis"))
+	_ = v.Parse(b)
+
+	assert.Equal(t, []string{"Cult of Luna", "Isis"}, v.GetArtists())
+	assert.Equal(t, "Cult of Luna / Isis", v.GetArtist())
+
+	f := &File{V1: &V1{Artist: "Bob"}, V2: v}
+	assert.Equal(t, []string{"Cult of Luna", "Isis"}, f.GetArtists())
+
+	f.V2 = &V2{}
+	assert.Equal(t, []string{"Bob"}, f.GetArtists())
+}
+
+func TestV2Unsynchronised(t *testing.T) {
+	assert := assert.New(t)
+	tag := "ID3\x03\x00\x80\x00\x00\x00\x19" +
+		"APIC\x00\x00\x00\x0a\x00\x00\x00img\x00\x03\x00\xff\x00\xe0\x01" +
+		"\x00\x00\x00\x00"
+
+	b := &tfile{}
+	_, _ = b.Write([]byte(tag))
+	v := &V2{}
+	assert.Nil(v.Parse(b))
+	assert.True(v.Unsynchronised)
+	assert.Equal(1, len(v.Frames))
+	assert.Equal([]byte("\xff\xe0\x01"), v.Frames[0].(*frames.APIC).Image)
+	assert.Equal(4, v.Padding)
+
+	var o bytes.Buffer
+	_, err := v.WriteTo(&o)
+	assert.Nil(err)
+	assert.Equal([]byte(tag), o.Bytes())
+}
+
+func TestV2FrameUnsynchronised(t *testing.T) {
+	assert := assert.New(t)
+	tag := "ID3\x04\x00\x00\x00\x00\x00\x15" +
+		"APIC\x00\x00\x00\x0b\x00\x02\x00img\x00\x03\x00\xff\x00\xe0\x01"
+
+	b := &tfile{}
+	_, _ = b.Write([]byte(tag))
+	v := &V2{}
+	assert.Nil(v.Parse(b))
+	assert.False(v.Unsynchronised)
+	assert.Equal([]byte("\xff\xe0\x01"), v.Frames[0].(*frames.APIC).Image)
+
+	// without the tag flag, frames are written as they are
+	out, err := v.Marshal()
+	assert.Nil(err)
+	assert.Equal("APIC\x00\x00\x00\x0a\x00\x00", string(out[10:20]))
+
+	v.Unsynchronised = true
+	out, err = v.Marshal()
+	assert.Nil(err)
+	assert.Equal(byte(0x80), out[5])
+	assert.Equal("APIC\x00\x00\x00\x0b\x00\x02", string(out[10:20]))
+
+	b = &tfile{}
+	_, _ = b.Write(out)
+	x := &V2{}
+	assert.Nil(x.Parse(b))
+	assert.True(x.Unsynchronised)
+	assert.Equal(v.Frames[0].(*frames.APIC).Image, x.Frames[0].(*frames.APIC).Image)
+}
+
+func TestV2FrameFlags(t *testing.T) {
+	assert := assert.New(t)
+	tag := "ID3\x03\x00\x00\x00\x00\x00\x18" +
+		"TPE1\x00\x00\x00\x0e\x20\x20\x09\x00Cult of Luna"
+
+	b := &tfile{}
+	_, _ = b.Write([]byte(tag))
+	v := &V2{}
+	assert.Nil(v.Parse(b))
+	base := v.Frames[0].GetBase()
+	assert.True(base.ReadOnly)
+	assert.True(base.Grouping)
+	assert.Equal(byte(9), base.GroupID)
+	assert.Equal("Cult of Luna", v.GetArtist())
+
+	out, err := v.Marshal()
+	assert.Nil(err)
+	assert.Equal([]byte(tag), out)
+
+	tag = "ID3\x04\x00\x00\x00\x00\x00\x39" +
+		"TPE1\x00\x00\x00\x12\x00\x41\x07\x00\x00\x00\x0d\x00Cult of Luna" +
+		"TPE2\x00\x00\x00\x06\x40\x00\x00Other" +
+		"XTRA\x00\x00\x00\x03\x40\x00abc"
+
+	b = &tfile{}
+	_, _ = b.Write([]byte(tag))
+	v = &V2{}
+	assert.Nil(v.Parse(b))
+	base = v.Frames[0].GetBase()
+	assert.True(base.DataLengthIndicator)
+	assert.Equal(13, base.DataLength)
+	assert.Equal(byte(7), base.GroupID)
+	assert.Equal("Cult of Luna", v.GetArtist())
+	assert.True(v.Frames[1].GetBase().TagPreserve)
+	assert.True(v.Frames[2].GetBase().TagPreserve)
+
+	// the altered tag leaves out the unknown frame marked for discarding, while known
+	// frames are kept as they can be brought up to date
+	out, err = v.Marshal()
+	assert.Nil(err)
+	assert.Equal([]byte("ID3\x04\x00\x00\x00\x00\x00\x2c"+tag[10:54]), out)
+}
+
+func TestV2EncryptedFrames(t *testing.T) {
+	assert := assert.New(t)
+	for _, tag := range []string{
+		"ID3\x03\x00\x00\x00\x00\x00\x10TPE1\x00\x00\x00\x06\x00\x40\x80\x11\x22\x33\x44\x55",
+		"ID3\x04\x00\x00\x00\x00\x00\x10TPE1\x00\x00\x00\x06\x00\x04\x80\x11\x22\x33\x44\x55",
+	} {
+		b := &tfile{}
+		_, _ = b.Write([]byte(tag))
+		v := &V2{}
+		assert.Nil(v.Parse(b))
+
+		u, ok := v.Frames[0].(*frames.UNKNOWN)
+		assert.True(ok)
+		assert.True(u.Encryption)
+		assert.Equal("", v.GetArtist())
+		assert.Equal([]string{}, v.GetArtists())
+
+		// the encrypted content, flags and method are written back untouched
+		v.CompressThreshold = 1
+		out, err := v.Marshal()
+		assert.Nil(err)
+		assert.Equal([]byte(tag), out)
+
+		v.Major = 7 - v.Major
+		_, err = v.Marshal()
+		assert.NotNil(err)
+	}
+}
+
+func TestV2Compression(t *testing.T) {
+	assert := assert.New(t)
+	image := bytes.Repeat([]byte{'\x01', '\x02', '\x03', '\x04'}, 64)
+	v := &V2{Major: frames.Version4}
+	a := frames.NewFrame("APIC", "Attached picture", frames.Version4).(*frames.APIC)
+	a.MimeType = "image/png"
+	a.Image = image
+	v.Frames = []frames.IFrame{a}
+
+	plain, err := v.Marshal()
+	assert.Nil(err)
+
+	v.CompressThreshold = 128
+	out, err := v.Marshal()
+	assert.Nil(err)
+	assert.True(len(out) < len(plain))
+	assert.Equal("\x00\x09", string(out[18:20]))
+
+	b := &tfile{}
+	_, _ = b.Write(out)
+	x := &V2{}
+	assert.Nil(x.Parse(b))
+	assert.Equal(1, len(x.Frames))
+	assert.True(x.Frames[0].GetBase().Compression)
+	assert.Equal(image, x.Frames[0].(*frames.APIC).Image)
+
+	// frames below the threshold are left alone
+	v.CompressThreshold = 1024
+	out, err = v.Marshal()
+	assert.Nil(err)
+	assert.Equal(plain, out)
+}
+
+func TestV2CorruptCompression(t *testing.T) {
+	assert := assert.New(t)
+	tag := "ID3\x03\x00\x00\x00\x00\x00\x29" +
+		"TIT2\x00\x00\x00\x08\x00\x80\x00\x00\x00\x04junk" +
+		"TPE1\x00\x00\x00\x0d\x00\x00\x00Cult of Luna"
+
+	v, err := parseWith(ParseOptions{}, tag)
+	assert.True(errors.Is(err, frames.ErrInvalid), "%s", err)
+	assert.Equal("Cult of Luna", v.GetArtist())
+	assert.Equal("", v.GetTitle())
+
+	// the compressed frame is kept, and written back as it was found
+	_, ok := v.Frames[0].(*frames.UNKNOWN)
+	assert.True(ok)
+	out, err := v.Marshal()
+	assert.Nil(err)
+	assert.Equal([]byte(tag), out)
+
+	_, err = parseWith(ParseOptions{Mode: ParseStrict}, tag)
+	assert.True(errors.Is(err, frames.ErrInvalid))
+
+	v, err = parseWith(ParseOptions{Mode: ParseLenient}, tag)
+	assert.Nil(err)
+	assert.Equal(1, len(v.Warnings))
+	assert.True(errors.Is(v.Warnings[0], frames.ErrInvalid))
+}
+
+func TestV2ExtendedHeader24(t *testing.T) {
+	assert := assert.New(t)
+	tag := "ID3\x04\x00\x40\x00\x00\x00\x26" +
+		"\x00\x00\x00\x0f\x01\x70\x00\x05\x01\x02\x03\x04\x05\x01\x6b" +
+		"TPE1\x00\x00\x00\x0d\x00\x00\x00Cult of Luna"
+
+	b := &tfile{}
+	_, _ = b.Write([]byte(tag))
+	v := &V2{}
+	assert.Nil(v.Parse(b))
+	assert.True(v.Extended)
+	assert.Equal(15, v.ExtendedSize)
+	assert.True(v.IsUpdate)
+	assert.True(v.Crc)
+	assert.Equal(uint32(1<<28|2<<21|3<<14|4<<7|5), v.CRC)
+	assert.Equal(NewRestrictions('\x6b'), v.Restrictions)
+	assert.Equal("Cult of Luna", v.GetArtist())
+	assert.Equal(0, v.Padding)
+
+	assert.False(v.CrcValid)
+
+	// the CRC is regenerated for what is written
+	out, err := v.Marshal()
+	assert.Nil(err)
+	assert.Equal("\x00\x00\x00\x0f\x01\x70\x00\x05", string(out[10:18]))
+	assert.Equal("\x01\x6b", string(out[23:25]))
+
+	b = &tfile{}
+	_, _ = b.Write(out)
+	x := &V2{}
+	assert.Nil(x.Parse(b))
+	assert.True(x.CrcValid)
+	assert.True(x.IsUpdate)
+	assert.Equal(v.Restrictions, x.Restrictions)
+	assert.Equal(v.Frames, x.Frames)
+}
+
+func TestV2ExtendedHeader23(t *testing.T) {
+	assert := assert.New(t)
+	v := &V2{Major: frames.Version3, Extended: true, Padding: 4}
+	out, err := v.Marshal()
+	assert.Nil(err)
+	assert.Equal("ID3\x03\x00\x40\x00\x00\x00\x0e\x00\x00\x00\x06\x00\x00\x00\x00\x00\x04\x00\x00\x00\x00", string(out))
+
+	b := &tfile{}
+	_, _ = b.Write(out)
+	x := &V2{}
+	assert.Nil(x.Parse(b))
+	assert.Equal(6, x.ExtendedSize)
+	assert.Equal(4, x.ExtendedPadding)
+	assert.Equal(4, x.Padding)
+}
+
+func TestV2CrcVerification(t *testing.T) {
+	assert := assert.New(t)
+	for _, major := range []int{frames.Version3, frames.Version4} {
+		v := &V2{Major: major, Extended: true, Crc: true, Padding: 6}
+		a := frames.NewFrame("TPE1", "Lead performer(s)/Soloist(s)", major).(*frames.TEXT)
+		a.SetValues("Cult of Luna")
+		v.Frames = []frames.IFrame{a}
+
+		out, err := v.Marshal()
+		assert.Nil(err)
+
+		b := &tfile{}
+		_, _ = b.Write(out)
+		x := &V2{}
+		assert.Nil(x.Parse(b))
+		assert.True(x.Crc)
+		assert.True(x.CrcValid, "v2.%d", major)
+		assert.Equal(6, x.Padding)
+		assert.Equal("Cult of Luna", x.GetArtist())
+
+		// v2.3 only covers the frames, where v2.4 takes in the padding as well
+		frame := out[len(out)-29 : len(out)-6]
+		if major == frames.Version3 {
+			assert.Equal(crc32.ChecksumIEEE(frame), x.CRC)
+		} else {
+			assert.Equal(crc32.ChecksumIEEE(append(frame, make([]byte, 6)...)), x.CRC)
+		}
+
+		// a change to the frame content is caught
+		out[len(out)-7] = 'X'
+		b = &tfile{}
+		_, _ = b.Write(out)
+		x = &V2{}
+		assert.Nil(x.Parse(b))
+		assert.False(x.CrcValid)
+	}
+}
+
+func TestV2UnknownFrames(t *testing.T) {
+	assert := assert.New(t)
+	tag := "ID3\x03\x00\x00\x00\x00\x00\x37" +
+		"TPE1\x00\x00\x00\x0d\x00\x00\x00Cult of Luna" +
+		"GRP1\x00\x00\x00\x06\x20\x00\x00Group" +
+		"XRVA\x00\x00\x00\x06\x00\x80\x00\x00\x00\x10\x78\x9c"
+
+	b := &tfile{}
+	_, _ = b.Write([]byte(tag))
+	v := &V2{}
+	assert.Nil(v.Parse(b))
+	assert.Equal(3, len(v.Frames))
+
+	u, ok := v.Frames[2].(*frames.UNKNOWN)
+	assert.True(ok)
+	assert.Equal("XRVA", u.Name)
+	assert.Equal([]byte("\x00\x00\x00\x10\x78\x9c"), u.Raw)
+	assert.True(u.Compression)
+
+	out, err := json.Marshal(v.Frames[1])
+	assert.Nil(err)
+	assert.Contains(string(out), `"raw":"AEdyb3Vw"`)
+
+	m, err := v.Marshal()
+	assert.Nil(err)
+	assert.Equal([]byte(tag), m)
+
+	v.Major = frames.Version4
+	_, err = v.Marshal()
+	assert.NotNil(err)
+
+	v.Frames = v.Frames[:2]
+	m, err = v.Marshal()
+	assert.Nil(err)
+	assert.Equal("GRP1\x00\x00\x00\x06\x10\x00\x00Group", string(m[33:49]))
+}
+
+func TestV2RegisteredFrame(t *testing.T) {
+	assert := assert.New(t)
+	tag := "ID3\x03\x00\x00\x00\x00\x00\x10" +
+		"MVNM\x00\x00\x00\x06\x00\x00\x00Movie"
+
+	b := &tfile{}
+	_, _ = b.Write([]byte(tag))
+	v := &V2{}
+	assert.Nil(v.Parse(b))
+	_, ok := v.Frames[0].(*frames.UNKNOWN)
+	assert.True(ok)
+
+	assert.Nil(frames.Register("MVNM", frames.Version3, "Movement name", func() frames.IFrame { return new(frames.TEXT) }))
+
+	b = &tfile{}
+	_, _ = b.Write([]byte(tag))
+	v = &V2{}
+	assert.Nil(v.Parse(b))
+	x, ok := v.Frames[0].(*frames.TEXT)
+	assert.True(ok)
+	assert.Equal("Movie", x.Cleaned)
+	assert.Equal("Movement name", x.GetExplain())
+}
+
+type customArtist struct {
+	frames.TEXT
+}
+
+func TestV2RegisteredTextOverride(t *testing.T) {
+	assert := assert.New(t)
+	defer func() {
+		_ = frames.Register("TPE1", frames.Version3, "Lead performer(s)/Soloist(s)", func() frames.IFrame { return new(frames.TEXT) })
+	}()
+	assert.Nil(frames.Register("TPE1", frames.Version3, "Artist", func() frames.IFrame { return new(customArtist) }))
+
+	b := &tfile{}
+	_, _ = b.Write([]byte("ID3\x03\x00\x00\x00\x00\x00\x28" +
+		"TPE1\x00\x00\x00\x0d\x00\x00\x00Cult of Luna" +
+		"TPE2\x00\x00\x00\x07\x00\x00\x00Julie"))
+	v := &V2{}
+	assert.Nil(v.Parse(b))
+
+	_, ok := v.Frames[0].(*customArtist)
+	assert.True(ok)
+	assert.Equal("Julie", v.GetArtist())
+	assert.Equal([]string{"Julie"}, v.GetArtists())
+}
+
+func FuzzV2Parse(f *testing.F) {
+	f.Add([]byte("ID3\x03\x00\x00\x00\x00\x00\x47" +
+		"TPE1\x00\x00\x00\x0d\x00\x00\x00Cult of Luna" +
+		"COMM\x00\x00\x00\x0e\x00\x00\x00engDesc\x00Hello" +
+		"APIC\x00\x00\x00\x0a\x00\x00\x00img\x00\x03\x00\x01\x02\x03" +
+		"\x00\x00\x00\x00"))
+	f.Add([]byte("ID3\x04\x00\x50\x00\x00\x00\x2a" +
+		"\x00\x00\x00\x0c\x01\x20\x05\x00\x00\x00\x00\x00" +
+		"TPE1\x00\x00\x00\x0d\x00\x03\x00Cult of Luna" +
+		"3DI\x04\x00\x10\x00\x00\x00\x2a"))
+	f.Add([]byte("ID3\x02\x00\x00\x00\x00\x00\x13" +
+		"TT2\x00\x00\x06\x00Title" +
+		"\x00\x00\x00\x00\x00\x00\x00"))
+
+	f.Fuzz(func(t *testing.T, d []byte) {
+		v := &V2{}
+		if err := v.Parse(rfile{bytes.NewReader(d)}); err != nil || v.TagSize() > len(d) {
+			return
+		}
+
+		_, _ = v.WriteTo(&bytes.Buffer{})
+	})
+}
+
+// rfile is a file held in memory, reporting the end of its content unlike tfile
+type rfile struct {
+	*bytes.Reader
+}
+
+func (rfile) Close() error {
+	return nil
+}
+
+func (rfile) Write(b []byte) (int, error) {
+	return 0, io.ErrShortWrite
+}